@@ -0,0 +1,215 @@
+package locale
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// maxAcceptLanguageEntries limits the number of Accept-Language entries
+// considered, protecting servers from oversized headers.
+const maxAcceptLanguageEntries = 64
+
+// WeightedTag is a language tag with an Accept-Language quality value.
+// The "*" wildcard is represented by the zero Tag.
+type WeightedTag struct {
+	Tag Tag
+	Q   float64
+}
+
+// IsWildcard returns true if the entry is the "*" wildcard.
+func (w WeightedTag) IsWildcard() bool {
+	return w.Tag.IsZero()
+}
+
+// ParseAcceptLanguage parses an HTTP Accept-Language header value as
+// defined by RFC 9110, returning the acceptable tags sorted by descending
+// quality, with ties kept in header order. Tags are canonicalized;
+// duplicates keep their highest quality, and entries with q=0 are treated
+// as exclusions and omitted. Malformed entries are skipped and reported in
+// the returned error, which wraps ErrInvalidTag, alongside the valid tags.
+func ParseAcceptLanguage(header string) ([]WeightedTag, error) {
+	accepted, _, err := parseAcceptLanguage(header)
+	return accepted, err
+}
+
+// parseAcceptLanguage parses an Accept-Language header into acceptable
+// tags and excluded (q=0) tags.
+func parseAcceptLanguage(header string) (accepted, excluded []WeightedTag, err error) {
+	var errs []error
+	entries := strings.Split(header, ",")
+	if len(entries) > maxAcceptLanguageEntries {
+		entries = entries[:maxAcceptLanguageEntries]
+	}
+
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		w, ok := parseAcceptLanguageEntry(entry)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: Accept-Language entry %q", ErrInvalidTag, entry))
+			continue
+		}
+
+		if w.Q == 0 {
+			excluded = append(excluded, w)
+			continue
+		}
+		if i := slices.IndexFunc(accepted, func(a WeightedTag) bool { return a.Tag == w.Tag }); i >= 0 {
+			accepted[i].Q = max(accepted[i].Q, w.Q)
+			continue
+		}
+		accepted = append(accepted, w)
+	}
+
+	// Exclusions override acceptance of the same tag
+	accepted = slices.DeleteFunc(accepted, func(a WeightedTag) bool {
+		return slices.ContainsFunc(excluded, func(e WeightedTag) bool { return e.Tag == a.Tag })
+	})
+	slices.SortStableFunc(accepted, func(a, b WeightedTag) int {
+		switch {
+		case a.Q > b.Q:
+			return -1
+		case a.Q < b.Q:
+			return 1
+		}
+		return 0
+	})
+	return accepted, excluded, errors.Join(errs...)
+}
+
+// parseAcceptLanguageEntry parses a single "tag;q=value" entry.
+// Parameters other than q are ignored.
+func parseAcceptLanguageEntry(entry string) (WeightedTag, bool) {
+	params := strings.Split(entry, ";")
+	w := WeightedTag{Q: 1}
+
+	if rng := strings.TrimSpace(params[0]); rng != "*" {
+		t, err := Parse(rng, WithCanonicalization())
+		if err != nil {
+			return WeightedTag{}, false
+		}
+		w.Tag = t
+	}
+
+	for _, p := range params[1:] {
+		name, value, ok := strings.Cut(strings.TrimSpace(p), "=")
+		if !ok || !strings.EqualFold(strings.TrimSpace(name), "q") {
+			continue
+		}
+		q, ok := parseQValue(strings.TrimSpace(value))
+		if !ok {
+			return WeightedTag{}, false
+		}
+		w.Q = q
+	}
+	return w, true
+}
+
+// parseQValue parses an RFC 9110 qvalue: "0" or "1" with up to three
+// decimal digits, in the range 0 to 1.
+func parseQValue(s string) (float64, bool) {
+	if s == "" || len(s) > 5 || (s[0] != '0' && s[0] != '1') {
+		return 0, false
+	}
+	if len(s) > 1 && (s[1] != '.' || !isDigit(s[2:])) {
+		return 0, false
+	}
+	q, err := strconv.ParseFloat(s, 64)
+	if err != nil || q > 1 {
+		return 0, false
+	}
+	return q, true
+}
+
+// ErrNotAcceptable is returned by NegotiateAcceptable when the
+// Accept-Language header excludes every available locale.
+var ErrNotAcceptable = errors.New("no acceptable locale")
+
+// Negotiate selects the available locale that best serves an HTTP
+// Accept-Language header, returning it as given in available. Tags are
+// tried in quality order using CLDR language matching and fallback rules,
+// so "fr-CA" is served by "fr" and "es-MX" prefers "es-419". Available
+// locales excluded with q=0, either exactly or by prefix, are never chosen;
+// "*;q=0" excludes the locales that no accepted language matches. If
+// nothing matches, the default locale is returned unless it is excluded,
+// in which case the first remaining available locale is used.
+//
+// If every available locale is excluded, Negotiate returns the default
+// locale, disregarding the header as RFC 9110 permits. Use
+// NegotiateAcceptable to detect this case.
+func Negotiate(acceptLanguage string, available []string, defaultLocale string) string {
+	loc, _ := NegotiateAcceptable(acceptLanguage, available, defaultLocale)
+	return loc
+}
+
+// NegotiateAcceptable is like Negotiate, but returns the default locale
+// with ErrNotAcceptable if the header excludes every available locale.
+func NegotiateAcceptable(acceptLanguage string, available []string, defaultLocale string) (string, error) {
+	accepted, excluded, _ := parseAcceptLanguage(acceptLanguage)
+
+	// isAccepted reports whether an explicitly accepted language matches t
+	isAccepted := func(t Tag) bool {
+		m := NewMatcher([]Tag{t})
+		return slices.ContainsFunc(accepted, func(w WeightedTag) bool {
+			if w.IsWildcard() {
+				return false
+			}
+			_, _, conf := m.Match(w.Tag)
+			return conf != No
+		})
+	}
+	isExcluded := func(t Tag) bool {
+		return slices.ContainsFunc(excluded, func(e WeightedTag) bool {
+			if e.IsWildcard() {
+				return !isAccepted(t)
+			}
+			return t == e.Tag || strings.HasPrefix(t.String(), e.Tag.String()+"-")
+		})
+	}
+
+	// Supported tags: the default locale first so it wins ties, then the
+	// remaining available locales in order
+	var supported []Tag
+	var originals []string
+	add := func(loc string) {
+		t, err := Parse(loc, WithCanonicalization())
+		if err != nil || isExcluded(t) || slices.Contains(supported, t) {
+			return
+		}
+		supported = append(supported, t)
+		originals = append(originals, loc)
+	}
+	if slices.ContainsFunc(available, func(loc string) bool { return loc == defaultLocale }) {
+		add(defaultLocale)
+	}
+	for _, loc := range available {
+		add(loc)
+	}
+	if len(supported) == 0 {
+		if len(available) > 0 && len(excluded) > 0 {
+			return defaultLocale, fmt.Errorf("%w: %q", ErrNotAcceptable, acceptLanguage)
+		}
+		return defaultLocale, nil
+	}
+
+	desired := make([]Tag, 0, len(accepted))
+	for _, w := range accepted {
+		if !w.IsWildcard() {
+			desired = append(desired, w.Tag)
+		}
+	}
+	if _, i, conf := NewMatcher(supported).Match(desired...); conf != No {
+		return originals[i], nil
+	}
+
+	if def, err := Parse(defaultLocale, WithCanonicalization()); err == nil && !isExcluded(def) {
+		return defaultLocale, nil
+	}
+	return originals[0], nil
+}
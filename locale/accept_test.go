@@ -0,0 +1,107 @@
+package locale
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected []string
+		wantErr  bool
+	}{
+		{"empty", "", nil, false},
+		{"single", "fr-CA", []string{"fr-CA;1"}, false},
+		{"ordered by q", "en;q=0.5, fr-CA, fr;q=0.8", []string{"fr-CA;1", "fr;0.8", "en;0.5"}, false},
+		{"ties keep order", "de;q=0.7,fr;q=0.7", []string{"de;0.7", "fr;0.7"}, false},
+		{"whitespace", "  en-US ;  q=0.9 ,ja\t", []string{"ja;1", "en-US;0.9"}, false},
+		{"wildcard", "fr, *;q=0.1", []string{"fr;1", "*;0.1"}, false},
+		{"q=0 excluded", "fr, en;q=0", []string{"fr;1"}, false},
+		{"duplicates keep highest", "fr;q=0.2, en, fr;q=0.9", []string{"en;1", "fr;0.9"}, false},
+		{"canonicalized", "iw, he;q=0.5", []string{"he;1"}, false},
+		{"other params ignored", "fr;level=1;q=0.5", []string{"fr;0.5"}, false},
+		{"empty entries", "fr,,en;q=0.5,", []string{"fr;1", "en;0.5"}, false},
+		{"malformed tag skipped", "fr, not_a_tag!, en;q=0.5", []string{"fr;1", "en;0.5"}, true},
+		{"malformed q skipped", "fr;q=2, en;q=abc, de;q=0.1234, ja;q=0.3", []string{"ja;0.3"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAcceptLanguage(tt.header)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseAcceptLanguage(%q) error = %v, wantErr %v", tt.header, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidTag) {
+				t.Errorf("ParseAcceptLanguage(%q) error = %v, expected ErrInvalidTag", tt.header, err)
+			}
+
+			var entries []string
+			for _, w := range got {
+				name := w.Tag.String()
+				if w.IsWildcard() {
+					name = "*"
+				}
+				entries = append(entries, name+";"+strconv.FormatFloat(w.Q, 'f', -1, 64))
+			}
+			if !reflect.DeepEqual(entries, tt.expected) {
+				t.Errorf("ParseAcceptLanguage(%q) = %v, expected %v", tt.header, entries, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	available := []string{"de", "en", "es-419", "es", "fr", "zh-Hant"}
+
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{"empty header", "", "en"},
+		{"exact", "de", "de"},
+		{"region fallback", "fr-CA,en;q=0.5", "fr"},
+		{"parent locale", "es-MX", "es-419"},
+		{"quality order", "ja, fr;q=0.4, de;q=0.8", "de"},
+		{"script aware", "zh-TW", "zh-Hant"},
+		{"no match uses default", "ja, ko", "en"},
+		{"excluded default", "ja, en;q=0", "de"},
+		{"excluded by prefix", "es-MX, es;q=0", "en"},
+		{"wildcard", "ja, *;q=0.1", "en"},
+		{"malformed entries ignored", "!!, fr", "fr"},
+		{"wildcard exclusion keeps accepted", "fr, *;q=0", "fr"},
+		{"wildcard exclusion keeps matched region", "fr-CA, *;q=0", "fr"},
+		{"wildcard exclusion with no match", "ja, *;q=0", "en"},
+		{"wildcard excludes all", "*;q=0", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Negotiate(tt.header, available, "en")
+			if got != tt.expected {
+				t.Errorf("Negotiate(%q) = %q, expected %q", tt.header, got, tt.expected)
+			}
+		})
+	}
+
+	if got := Negotiate("fr", nil, "en"); got != "en" {
+		t.Errorf("Negotiate with no available locales = %q, expected 'en'", got)
+	}
+}
+
+func TestNegotiateAcceptable(t *testing.T) {
+	available := []string{"en", "fr"}
+	for _, header := range []string{"*;q=0", "ja, *;q=0", "en;q=0, fr;q=0"} {
+		got, err := NegotiateAcceptable(header, available, "en")
+		if !errors.Is(err, ErrNotAcceptable) || got != "en" {
+			t.Errorf("NegotiateAcceptable(%q) = %q, %v, expected en with ErrNotAcceptable", header, got, err)
+		}
+	}
+	if got, err := NegotiateAcceptable("fr, *;q=0", available, "en"); err != nil || got != "fr" {
+		t.Errorf("NegotiateAcceptable(fr, *;q=0) = %q, %v, expected fr", got, err)
+	}
+}
@@ -0,0 +1,429 @@
+package locale
+
+import (
+	"slices"
+	"strings"
+)
+
+// ParseOption configures optional behavior of Parse.
+type ParseOption func(*parseOptions)
+
+type parseOptions struct {
+	canonicalize bool
+}
+
+// WithCanonicalization makes Parse replace deprecated and alias subtags
+// with their preferred forms, as done by Canonicalize.
+func WithCanonicalization() ParseOption {
+	return func(o *parseOptions) {
+		o.canonicalize = true
+	}
+}
+
+// Canonicalize returns the canonical form of a tag by replacing deprecated
+// and alias subtags with their preferred values from the IANA Language
+// Subtag Registry and CLDR supplemental metadata. For example "iw" becomes
+// "he", "zh-min-nan" becomes "nan", "sh" becomes "sr-Latn" and "de-DD"
+// becomes "de-DE".
+func Canonicalize(t Tag) Tag {
+	if t.IsZero() {
+		return t
+	}
+
+	// Regular grandfathered tags, e.g. "zh-min-nan" and "art-lojban"
+	key := t.Language
+	switch {
+	case t.extlang != "":
+		key += "-" + t.extlang
+	case t.variants != "" && !strings.Contains(t.variants, "-"):
+		key += "-" + t.variants
+	}
+	if preferred, ok := grandfatheredRegular[key]; ok {
+		if t.extlang == "" {
+			t.variants = ""
+		}
+		t.extlang = ""
+		t.Language = preferred
+	}
+
+	// Registered extended language subtags are replaced by the extlang
+	// itself, e.g. "zh-yue" becomes "yue". Others are left unchanged.
+	if ext, _, _ := strings.Cut(t.extlang, "-"); ext != "" && extlangPrefixes[ext] == t.Language {
+		t.Language = ext
+		t.extlang = ""
+	}
+
+	// Language aliases may also supply a script or region, e.g. "sh" is
+	// "sr-Latn"; these only fill subtags the tag does not already have.
+	if replacement, ok := languageAliases[t.Language]; ok {
+		lang, rest, _ := strings.Cut(replacement, "-")
+		t.Language = lang
+		for _, s := range splitSubtags(rest) {
+			if len(s) == 4 && t.Script == "" {
+				t.Script = s
+			} else if len(s) != 4 && t.Region == "" {
+				t.Region = s
+			}
+		}
+	}
+
+	if replacement, ok := scriptAliases[t.Script]; ok {
+		t.Script = replacement
+	}
+	if replacement, ok := regionAliases[t.Region]; ok {
+		t.Region = replacement
+	}
+
+	if t.variants != "" {
+		variants := t.Variants()
+		for i, v := range variants {
+			if replacement, ok := variantAliases[v]; ok {
+				variants[i] = replacement
+			}
+		}
+		t.variants = strings.Join(slices.Compact(variants), "-")
+	}
+
+	// Unicode locale extension type aliases, e.g. "ca-islamicc"
+	for _, kw := range t.UnicodeKeywords() {
+		if replacement, ok := unicodeTypeAliases[kw.Key+"-"+kw.Type]; ok {
+			// Replacements are well-formed, so the error can be ignored.
+			t, _ = t.WithUnicodeKeyword(kw.Key, replacement)
+		}
+	}
+
+	return t
+}
+
+// grandfatheredIrregular maps irregular grandfathered tags, which do not
+// match the RFC 5646 langtag syntax, to their preferred values. Parse
+// always applies this mapping because Tag cannot represent the original
+// form. Irregular tags without a preferred value are rejected.
+var grandfatheredIrregular = map[string]string{
+	"en-gb-oed": "en-GB-oxendict",
+	"i-ami":     "ami",
+	"i-bnn":     "bnn",
+	"i-hak":     "hak",
+	"i-klingon": "tlh",
+	"i-lux":     "lb",
+	"i-navajo":  "nv",
+	"i-pwn":     "pwn",
+	"i-tao":     "tao",
+	"i-tay":     "tay",
+	"i-tsu":     "tsu",
+	"sgn-be-fr": "sfb",
+	"sgn-be-nl": "vgt",
+	"sgn-ch-de": "sgg",
+}
+
+// grandfatheredRegular maps regular grandfathered tags to preferred
+// language subtags.
+var grandfatheredRegular = map[string]string{
+	"art-lojban": "jbo",
+	"no-bok":     "nb",
+	"no-nyn":     "nn",
+	"zh-guoyu":   "zh",
+	"zh-hakka":   "hak",
+	"zh-min-nan": "nan",
+	"zh-xiang":   "hsn",
+}
+
+// languageAliases maps deprecated, legacy and overlong language subtags to
+// their replacements from CLDR languageAlias data. Replacements may
+// include a script and/or region.
+var languageAliases = map[string]string{
+	// Deprecated ISO 639 codes
+	"in": "id",
+	"iw": "he",
+	"ji": "yi",
+	"jw": "jv",
+	"mo": "ro",
+
+	// Legacy CLDR aliases
+	"sh":  "sr-Latn",
+	"tl":  "fil",
+	"cnr": "sr-ME",
+
+	// Macrolanguage encompassed languages
+	"arb": "ar",
+	"cmn": "zh",
+	"ekk": "et",
+	"lvs": "lv",
+	"nob": "nb",
+	"nno": "nn",
+	"pes": "fa",
+	"swh": "sw",
+	"zsm": "ms",
+
+	// ISO 639-2 terminology and bibliographic codes with 2-letter equivalents
+	"ara": "ar",
+	"ces": "cs",
+	"cze": "cs",
+	"chi": "zh",
+	"dan": "da",
+	"deu": "de",
+	"ger": "de",
+	"ell": "el",
+	"gre": "el",
+	"eng": "en",
+	"fas": "fa",
+	"per": "fa",
+	"fin": "fi",
+	"fra": "fr",
+	"fre": "fr",
+	"heb": "he",
+	"hin": "hi",
+	"hun": "hu",
+	"ind": "id",
+	"ita": "it",
+	"jpn": "ja",
+	"kor": "ko",
+	"msa": "ms",
+	"may": "ms",
+	"nld": "nl",
+	"dut": "nl",
+	"nor": "no",
+	"pol": "pl",
+	"por": "pt",
+	"ron": "ro",
+	"rum": "ro",
+	"rus": "ru",
+	"slk": "sk",
+	"slo": "sk",
+	"spa": "es",
+	"swe": "sv",
+	"tha": "th",
+	"tur": "tr",
+	"ukr": "uk",
+	"vie": "vi",
+	"zho": "zh",
+}
+
+// extlangPrefixes maps extended language subtags to their required
+// prefix language, from the IANA Language Subtag Registry. Only extlangs
+// of macrolanguages in common use are listed.
+var extlangPrefixes = map[string]string{
+	// ar
+	"aao": "ar",
+	"abh": "ar",
+	"abv": "ar",
+	"acm": "ar",
+	"acq": "ar",
+	"acw": "ar",
+	"acx": "ar",
+	"acy": "ar",
+	"adf": "ar",
+	"aeb": "ar",
+	"aec": "ar",
+	"afb": "ar",
+	"ajp": "ar",
+	"apc": "ar",
+	"apd": "ar",
+	"arb": "ar",
+	"arq": "ar",
+	"ars": "ar",
+	"ary": "ar",
+	"arz": "ar",
+	"auz": "ar",
+	"avl": "ar",
+	"ayh": "ar",
+	"ayl": "ar",
+	"ayn": "ar",
+	"ayp": "ar",
+	"bbz": "ar",
+	"pga": "ar",
+	"shu": "ar",
+	"ssh": "ar",
+
+	// et
+	"ekk": "et",
+	"vro": "et",
+
+	// fa
+	"pes": "fa",
+	"prs": "fa",
+
+	// lv
+	"ltg": "lv",
+	"lvs": "lv",
+
+	// mn
+	"khk": "mn",
+	"mvf": "mn",
+
+	// ms
+	"bjn": "ms",
+	"btj": "ms",
+	"bve": "ms",
+	"bvu": "ms",
+	"coa": "ms",
+	"dup": "ms",
+	"hji": "ms",
+	"jak": "ms",
+	"jax": "ms",
+	"kvb": "ms",
+	"kvr": "ms",
+	"kxd": "ms",
+	"lce": "ms",
+	"lcf": "ms",
+	"liw": "ms",
+	"max": "ms",
+	"meo": "ms",
+	"mfa": "ms",
+	"mfb": "ms",
+	"min": "ms",
+	"mqg": "ms",
+	"msi": "ms",
+	"mui": "ms",
+	"orn": "ms",
+	"ors": "ms",
+	"pel": "ms",
+	"pse": "ms",
+	"tmw": "ms",
+	"urk": "ms",
+	"vkk": "ms",
+	"vkt": "ms",
+	"xmm": "ms",
+	"zlm": "ms",
+	"zmi": "ms",
+	"zsm": "ms",
+
+	// ps
+	"pbt": "ps",
+	"pbu": "ps",
+	"pst": "ps",
+
+	// sq
+	"aae": "sq",
+	"aat": "sq",
+	"aln": "sq",
+	"als": "sq",
+
+	// sw
+	"swc": "sw",
+	"swh": "sw",
+
+	// uz
+	"uzn": "uz",
+	"uzs": "uz",
+
+	// zh
+	"cdo": "zh",
+	"cjy": "zh",
+	"cmn": "zh",
+	"cnp": "zh",
+	"cpx": "zh",
+	"csp": "zh",
+	"czh": "zh",
+	"czo": "zh",
+	"gan": "zh",
+	"hak": "zh",
+	"hsn": "zh",
+	"lzh": "zh",
+	"mnp": "zh",
+	"nan": "zh",
+	"wuu": "zh",
+	"yue": "zh",
+}
+
+// scriptAliases maps deprecated script subtags to their replacements.
+var scriptAliases = map[string]string{
+	"Qaai": "Zinh",
+}
+
+// regionAliases maps deprecated and numeric region subtags to their
+// replacements from CLDR territoryAlias data. Regions that split into
+// several successors map to the most populous one.
+var regionAliases = map[string]string{
+	// Deprecated ISO 3166-1 codes
+	"AN": "CW",
+	"BU": "MM",
+	"CS": "RS",
+	"CT": "KI",
+	"DD": "DE",
+	"DY": "BJ",
+	"FQ": "AQ",
+	"FX": "FR",
+	"HV": "BF",
+	"JT": "UM",
+	"MI": "UM",
+	"NH": "VU",
+	"NQ": "AQ",
+	"NT": "SA",
+	"PC": "FM",
+	"PU": "UM",
+	"PZ": "PA",
+	"QU": "EU",
+	"RH": "ZW",
+	"SU": "RU",
+	"TP": "TL",
+	"UK": "GB",
+	"VD": "VN",
+	"WK": "UM",
+	"YD": "YE",
+	"YU": "RS",
+	"ZR": "CD",
+
+	// UN M.49 numeric codes for common countries
+	"036": "AU",
+	"076": "BR",
+	"124": "CA",
+	"156": "CN",
+	"250": "FR",
+	"276": "DE",
+	"344": "HK",
+	"356": "IN",
+	"380": "IT",
+	"392": "JP",
+	"410": "KR",
+	"484": "MX",
+	"528": "NL",
+	"643": "RU",
+	"724": "ES",
+	"756": "CH",
+	"826": "GB",
+	"840": "US",
+	"158": "TW",
+}
+
+// variantAliases maps deprecated variant subtags to their replacements.
+var variantAliases = map[string]string{
+	"heploc":   "alalc97",
+	"polytoni": "polyton",
+}
+
+// unicodeTypeAliases maps deprecated Unicode locale extension key-type
+// pairs to replacement types from CLDR BCP 47 data.
+var unicodeTypeAliases = map[string]string{
+	"ca-ethiopic-amete-alem": "ethioaa",
+	"ca-islamicc":            "islamic-civil",
+	"ms-imperial":            "uksystem",
+	"tz-aqams":               "nzakl",
+	"tz-cnckg":               "cnsha",
+	"tz-cnhrb":               "cnsha",
+	"tz-cnkhg":               "cnurc",
+	"tz-cuba":                "cuhav",
+	"tz-egypt":               "egcai",
+	"tz-eire":                "iedub",
+	"tz-est":                 "utcw05",
+	"tz-gmt0":                "gmt",
+	"tz-hongkong":            "hkhkg",
+	"tz-hst":                 "utcw10",
+	"tz-iceland":             "isrey",
+	"tz-iran":                "irthr",
+	"tz-israel":              "jeruslm",
+	"tz-jamaica":             "jmkin",
+	"tz-japan":               "jptyo",
+	"tz-libya":               "lytip",
+	"tz-mst":                 "utcw07",
+	"tz-navajo":              "usden",
+	"tz-poland":              "plwaw",
+	"tz-portugal":            "ptlis",
+	"tz-prc":                 "cnsha",
+	"tz-roc":                 "twtpe",
+	"tz-rok":                 "krsel",
+	"tz-turkey":              "trist",
+	"tz-uct":                 "utc",
+	"tz-usnavajo":            "usden",
+	"tz-zulu":                "utc",
+}
@@ -0,0 +1,94 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestCanonicalize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// Deprecated language codes
+		{"iw", "he"},
+		{"iw-IL", "he-IL"},
+		{"in", "id"},
+		{"ji", "yi"},
+		{"tl", "fil"},
+		{"tl-PH", "fil-PH"},
+
+		// Aliases supplying a script or region
+		{"sh", "sr-Latn"},
+		{"sh-Cyrl", "sr-Cyrl"},
+		{"cnr", "sr-ME"},
+
+		// Overlong and macrolanguage codes
+		{"jpn", "ja"},
+		{"ger-AT", "de-AT"},
+		{"cmn-Hans-CN", "zh-Hans-CN"},
+
+		// Extended language subtags
+		{"zh-yue", "yue"},
+		{"zh-yue-HK", "yue-HK"},
+		{"zh-cmn-Hant", "zh-Hant"},
+		{"ar-arb", "ar"},
+		{"ms-zsm-MY", "ms-MY"},
+		{"de-abc", "de-abc"},
+		{"en-usa", "en-usa"},
+		{"fr-yue-CA", "fr-yue-CA"},
+
+		// Grandfathered tags
+		{"i-klingon", "tlh"},
+		{"zh-min-nan", "nan"},
+		{"art-lojban", "jbo"},
+		{"no-bok", "nb"},
+		{"en-GB-oed", "en-GB-oxendict"},
+
+		// Region, script and variant aliases
+		{"de-DD", "de-DE"},
+		{"en-UK", "en-GB"},
+		{"es-840", "es-US"},
+		{"es-419", "es-419"},
+		{"und-Qaai", "und-Zinh"},
+		{"el-polytoni", "el-polyton"},
+
+		// Unicode locale extension type aliases
+		{"ar-u-ca-islamicc", "ar-u-ca-islamic-civil"},
+		{"en-GB-u-ms-imperial", "en-GB-u-ms-uksystem"},
+
+		// Already canonical
+		{"he-IL-u-nu-hebr-x-foo", "he-IL-u-nu-hebr-x-foo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input, WithCanonicalization())
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("Parse(%q, WithCanonicalization()) = %q, expected %q", tt.input, got.String(), tt.expected)
+			}
+			if again := Canonicalize(got); again != got {
+				t.Errorf("Canonicalize(%q) = %q, expected idempotent", got.String(), again.String())
+			}
+		})
+	}
+}
+
+func TestParse_WithoutCanonicalization(t *testing.T) {
+	// Aliases are kept unless canonicalization is requested
+	for _, input := range []string{"iw", "jpn", "zh-min-nan", "de-DD"} {
+		if got := MustParse(input).String(); got != input {
+			t.Errorf("Parse(%q) = %q, expected unchanged", input, got)
+		}
+	}
+
+	// Irregular grandfathered tags are always mapped
+	if got := MustParse("i-klingon").String(); got != "tlh" {
+		t.Errorf("Parse(\"i-klingon\") = %q, expected 'tlh'", got)
+	}
+	if _, err := Parse("i-default"); err == nil {
+		t.Error("Parse(\"i-default\") expected error")
+	}
+}
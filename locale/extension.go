@@ -0,0 +1,101 @@
+package locale
+
+import (
+	"slices"
+	"strings"
+)
+
+// Extension represents a BCP 47 extension sequence such as "u-ca-gregory".
+type Extension struct {
+	Singleton byte     // extension singleton, e.g. 'u' or 't' (lowercase)
+	Subtags   []string // subtags following the singleton (lowercase)
+}
+
+// String returns the extension in BCP 47 form, e.g. "u-ca-gregory".
+func (e Extension) String() string {
+	if e.Singleton == 0 {
+		return ""
+	}
+	return string(e.Singleton) + "-" + strings.Join(e.Subtags, "-")
+}
+
+// Variants returns the variant subtags of the tag in order, e.g.
+// ["rozaj", "biske"] for "sl-rozaj-biske".
+func (t Tag) Variants() []string {
+	return splitSubtags(t.variants)
+}
+
+// ExtLangs returns the extended language subtags of the tag, e.g. ["yue"]
+// for "zh-yue".
+func (t Tag) ExtLangs() []string {
+	return splitSubtags(t.extlang)
+}
+
+// Extensions returns the extension sequences of the tag ordered by singleton.
+func (t Tag) Extensions() []Extension {
+	var exts []Extension
+	for _, part := range splitSubtags(t.extensions) {
+		if len(part) == 1 {
+			exts = append(exts, Extension{Singleton: part[0]})
+			continue
+		}
+		last := &exts[len(exts)-1]
+		last.Subtags = append(last.Subtags, part)
+	}
+	return exts
+}
+
+// Extension returns the extension sequence for the given singleton.
+// The singleton is case-insensitive.
+func (t Tag) Extension(singleton byte) (Extension, bool) {
+	if singleton >= 'A' && singleton <= 'Z' {
+		singleton += 'a' - 'A'
+	}
+	for _, e := range t.Extensions() {
+		if e.Singleton == singleton {
+			return e, true
+		}
+	}
+	return Extension{}, false
+}
+
+// PrivateUse returns the private use subtags of the tag without the "x"
+// singleton, e.g. ["twain"] for "en-US-x-twain".
+func (t Tag) PrivateUse() []string {
+	return splitSubtags(t.privateUse)
+}
+
+// withExtension returns a copy of the tag with the extension for e's
+// singleton replaced by e. An extension without subtags is removed.
+func (t Tag) withExtension(e Extension) Tag {
+	exts := slices.DeleteFunc(t.Extensions(), func(x Extension) bool {
+		return x.Singleton == e.Singleton
+	})
+	if len(e.Subtags) > 0 {
+		exts = append(exts, e)
+	}
+	t.extensions = joinExtensions(exts)
+	return t
+}
+
+// splitSubtags splits a "-"-joined subtag string, returning nil when empty.
+func splitSubtags(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "-")
+}
+
+// joinExtensions returns extension sequences in canonical order: sorted by
+// singleton, with each sequence's subtags kept in order.
+func joinExtensions(exts []Extension) string {
+	exts = slices.Clone(exts)
+	slices.SortFunc(exts, func(a, b Extension) int {
+		return int(a.Singleton) - int(b.Singleton)
+	})
+	parts := make([]string, 0, len(exts))
+	for _, e := range exts {
+		parts = append(parts, e.String())
+	}
+	return strings.Join(parts, "-")
+}
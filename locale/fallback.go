@@ -3,12 +3,17 @@ package locale
 // FallbackChain returns the fallback chain for a locale.
 // Example: "fr-CA" with default "en" returns ["fr-CA", "fr", "en"].
 // The chain always ends with the default locale if different from the input.
+// The tag is canonicalized first, so "iw-IL" starts the chain with "he-IL".
+//
+// Parents follow CLDR parentLocales data and are script-aware, so "es-MX"
+// falls back through "es-419", "en-IN" through "en-001", and "zh-TW" and
+// "zh-Hant-HK" through "zh-Hant" without reaching Simplified Chinese "zh".
 func FallbackChain(tag string, defaultLocale string) []string {
 	var chain []string
 	seen := make(map[string]bool)
 
 	// Parse the input tag
-	t, err := Parse(tag)
+	t, err := Parse(tag, WithCanonicalization())
 	if err != nil {
 		// Invalid tag, just use default
 		if defaultLocale != "" {
@@ -24,7 +29,7 @@ func FallbackChain(tag string, defaultLocale string) []string {
 			chain = append(chain, s)
 			seen[s] = true
 		}
-		t = t.Parent()
+		t = fallbackParent(t)
 	}
 
 	// Add default locale if not already in chain
@@ -35,17 +40,56 @@ func FallbackChain(tag string, defaultLocale string) []string {
 	return chain
 }
 
+// fallbackParent returns the CLDR parent of a tag, or the zero Tag when the
+// parent is the root locale. Explicit parentLocales entries take precedence;
+// otherwise extensions and then variants are removed one at a time as by
+// Tag.Parent, then the region, then the script. The script is kept when removing the region would change the
+// likely script, and a non-default script falls back to root rather than to
+// the bare language.
+func fallbackParent(t Tag) Tag {
+	if p, ok := parentLocales[t.String()]; ok {
+		if p == "root" {
+			return Tag{}
+		}
+		return MustParse(p)
+	}
+
+	if t.Base() != t {
+		return t.Parent()
+	}
+
+	defaultScript := Tag{Language: t.Language}.Maximize().Script
+	if t.Region != "" {
+		p := Tag{Language: t.Language, Script: t.Script}
+		if p.Script == "" {
+			if s := t.Maximize().Script; s != defaultScript {
+				p.Script = s
+			}
+		}
+		return p
+	}
+	if t.Script != "" && (defaultScript == "" || t.Script == defaultScript) {
+		return Tag{Language: t.Language}
+	}
+	if t.Script == "" && t.extlang != "" {
+		// Unregistered extended language, e.g. "de-abc"
+		return t.Parent()
+	}
+	return Tag{}
+}
+
 // BestMatch finds the best matching locale from available locales.
 // Returns the first match in the fallback chain, or the default locale if no match.
+// Use Matcher for distance-based matching across regions and related languages.
 func BestMatch(requested string, available []string, defaultLocale string) string {
 	if len(available) == 0 {
 		return defaultLocale
 	}
 
-	// Build set of available locales (normalized)
+	// Build set of available locales (normalized and canonicalized)
 	availableSet := make(map[string]string, len(available))
 	for _, loc := range available {
-		t, err := Parse(loc)
+		t, err := Parse(loc, WithCanonicalization())
 		if err != nil {
 			continue
 		}
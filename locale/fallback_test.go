@@ -108,3 +108,50 @@ func TestBestMatch(t *testing.T) {
 		})
 	}
 }
+
+func TestFallbackChain_Canonicalized(t *testing.T) {
+	got := FallbackChain("iw-IL", "en")
+	expected := []string{"he-IL", "he", "en"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("FallbackChain(\"iw-IL\", \"en\") = %v, expected %v", got, expected)
+	}
+
+	if got := BestMatch("he", []string{"en", "iw"}, "en"); got != "iw" {
+		t.Errorf("BestMatch(\"he\", [en iw]) = %q, expected 'iw'", got)
+	}
+}
+
+func TestFallbackChain_ParentLocales(t *testing.T) {
+	tests := []struct {
+		tag      string
+		expected []string
+	}{
+		{"es-MX", []string{"es-MX", "es-419", "es", "en"}},
+		{"en-IN", []string{"en-IN", "en-001", "en"}},
+		{"en-AT", []string{"en-AT", "en-150", "en-001", "en"}},
+		{"pt-AO", []string{"pt-AO", "pt-PT", "pt", "en"}},
+		{"zh-Hant-HK", []string{"zh-Hant-HK", "zh-Hant", "en"}},
+		{"zh-Hant-MO", []string{"zh-Hant-MO", "zh-Hant-HK", "zh-Hant", "en"}},
+		{"zh-TW", []string{"zh-TW", "zh-Hant", "en"}},
+		{"zh-HK", []string{"zh-HK", "zh-Hant", "en"}},
+		{"zh-CN", []string{"zh-CN", "zh", "en"}},
+		{"sr-Latn-RS", []string{"sr-Latn-RS", "sr-Latn", "en"}},
+		{"sr-ME", []string{"sr-ME", "sr-Latn", "en"}},
+		{"nb-NO", []string{"nb-NO", "nb", "no", "en"}},
+		{"de-CH-1996", []string{"de-CH-1996", "de-CH", "de", "en"}},
+		{"sl-rozaj-biske", []string{"sl-rozaj-biske", "sl-rozaj", "sl", "en"}},
+		{"sl-IT-rozaj-biske-u-ca-gregory", []string{"sl-IT-rozaj-biske-u-ca-gregory", "sl-IT-rozaj-biske", "sl-IT-rozaj", "sl-IT", "sl", "en"}},
+		{"xx-Latn-US", []string{"xx-Latn-US", "xx-Latn", "xx", "en"}},
+		{"de-abc", []string{"de-abc", "de", "en"}},
+		{"zh-yue-HK", []string{"yue-HK", "yue", "en"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got := FallbackChain(tt.tag, "en")
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("FallbackChain(%q, \"en\") = %v, expected %v", tt.tag, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,255 @@
+package locale
+
+// Maximize returns the tag with missing script and region subtags filled in
+// using CLDR likely subtags data, following the "Add Likely Subtags"
+// algorithm of UTS #35. For example "zh-TW" becomes "zh-Hant-TW", "sr"
+// becomes "sr-Cyrl-RS" and "und-Hant" becomes "zh-Hant-TW". The tag is
+// canonicalized first; variants and extensions are preserved. If no
+// likely subtags are known the canonical tag is returned unchanged.
+func (t Tag) Maximize() Tag {
+	if t.IsZero() {
+		return t
+	}
+	t = Canonicalize(t)
+
+	// Lookup order: L_S_R, L_R, L_S, L, und_S
+	l, s, r := t.Language, t.Script, t.Region
+	candidates := []Tag{
+		{Language: l, Script: s, Region: r},
+		{Language: l, Region: r},
+		{Language: l, Script: s},
+		{Language: l},
+	}
+	if s != "" {
+		candidates = append(candidates, Tag{Language: "und", Script: s})
+	}
+	for _, c := range candidates {
+		likely, ok := likelySubtags[c.String()]
+		if !ok {
+			continue
+		}
+		m := MustParse(likely)
+		if t.Language == "und" {
+			t.Language = m.Language
+		}
+		if t.Script == "" {
+			t.Script = m.Script
+		}
+		if t.Region == "" {
+			t.Region = m.Region
+		}
+		return t
+	}
+	return t
+}
+
+// Minimize returns the shortest tag that maximizes to the same result,
+// following the "Remove Likely Subtags" algorithm of UTS #35 and
+// favoring region over script. For example "en-Latn-US" becomes "en" and
+// "zh-Hant-TW" becomes "zh-TW". Variants and extensions are preserved.
+func (t Tag) Minimize() Tag {
+	if t.IsZero() {
+		return t
+	}
+	maximized := t.Maximize()
+	base := maximized.Base()
+
+	for _, trial := range []Tag{
+		{Language: maximized.Language},
+		{Language: maximized.Language, Region: maximized.Region},
+		{Language: maximized.Language, Script: maximized.Script},
+	} {
+		if trial.Maximize() == base {
+			maximized.Script = trial.Script
+			maximized.Region = trial.Region
+			return maximized
+		}
+	}
+	return maximized
+}
+
+// likelySubtags maps language, script and region combinations to their
+// most likely full form, from CLDR supplemental likelySubtags data.
+var likelySubtags = map[string]string{
+	"und": "en-Latn-US",
+
+	// Languages
+	"af":  "af-Latn-ZA",
+	"am":  "am-Ethi-ET",
+	"ar":  "ar-Arab-EG",
+	"as":  "as-Beng-IN",
+	"az":  "az-Latn-AZ",
+	"be":  "be-Cyrl-BY",
+	"bg":  "bg-Cyrl-BG",
+	"bn":  "bn-Beng-BD",
+	"bs":  "bs-Latn-BA",
+	"ca":  "ca-Latn-ES",
+	"cs":  "cs-Latn-CZ",
+	"cy":  "cy-Latn-GB",
+	"da":  "da-Latn-DK",
+	"de":  "de-Latn-DE",
+	"el":  "el-Grek-GR",
+	"en":  "en-Latn-US",
+	"eo":  "eo-Latn-001",
+	"es":  "es-Latn-ES",
+	"et":  "et-Latn-EE",
+	"eu":  "eu-Latn-ES",
+	"fa":  "fa-Arab-IR",
+	"fi":  "fi-Latn-FI",
+	"fil": "fil-Latn-PH",
+	"fr":  "fr-Latn-FR",
+	"ga":  "ga-Latn-IE",
+	"gl":  "gl-Latn-ES",
+	"gu":  "gu-Gujr-IN",
+	"ha":  "ha-Latn-NG",
+	"he":  "he-Hebr-IL",
+	"hi":  "hi-Deva-IN",
+	"hr":  "hr-Latn-HR",
+	"hu":  "hu-Latn-HU",
+	"hy":  "hy-Armn-AM",
+	"id":  "id-Latn-ID",
+	"is":  "is-Latn-IS",
+	"it":  "it-Latn-IT",
+	"ja":  "ja-Jpan-JP",
+	"jv":  "jv-Latn-ID",
+	"ka":  "ka-Geor-GE",
+	"kk":  "kk-Cyrl-KZ",
+	"km":  "km-Khmr-KH",
+	"kn":  "kn-Knda-IN",
+	"ko":  "ko-Kore-KR",
+	"ku":  "ku-Latn-TR",
+	"ky":  "ky-Cyrl-KG",
+	"la":  "la-Latn-VA",
+	"lo":  "lo-Laoo-LA",
+	"lt":  "lt-Latn-LT",
+	"lv":  "lv-Latn-LV",
+	"mk":  "mk-Cyrl-MK",
+	"ml":  "ml-Mlym-IN",
+	"mn":  "mn-Cyrl-MN",
+	"mr":  "mr-Deva-IN",
+	"ms":  "ms-Latn-MY",
+	"mt":  "mt-Latn-MT",
+	"my":  "my-Mymr-MM",
+	"nb":  "nb-Latn-NO",
+	"ne":  "ne-Deva-NP",
+	"nl":  "nl-Latn-NL",
+	"nn":  "nn-Latn-NO",
+	"no":  "no-Latn-NO",
+	"pa":  "pa-Guru-IN",
+	"pl":  "pl-Latn-PL",
+	"ps":  "ps-Arab-AF",
+	"pt":  "pt-Latn-BR",
+	"ro":  "ro-Latn-RO",
+	"ru":  "ru-Cyrl-RU",
+	"si":  "si-Sinh-LK",
+	"sk":  "sk-Latn-SK",
+	"sl":  "sl-Latn-SI",
+	"so":  "so-Latn-SO",
+	"sq":  "sq-Latn-AL",
+	"sr":  "sr-Cyrl-RS",
+	"sv":  "sv-Latn-SE",
+	"sw":  "sw-Latn-TZ",
+	"ta":  "ta-Taml-IN",
+	"te":  "te-Telu-IN",
+	"th":  "th-Thai-TH",
+	"tr":  "tr-Latn-TR",
+	"uk":  "uk-Cyrl-UA",
+	"ur":  "ur-Arab-PK",
+	"uz":  "uz-Latn-UZ",
+	"vi":  "vi-Latn-VN",
+	"xh":  "xh-Latn-ZA",
+	"yue": "yue-Hant-HK",
+	"zh":  "zh-Hans-CN",
+	"zu":  "zu-Latn-ZA",
+
+	// Language and region
+	"az-IR":  "az-Arab-IR",
+	"pa-PK":  "pa-Arab-PK",
+	"sr-ME":  "sr-Latn-ME",
+	"uz-AF":  "uz-Arab-AF",
+	"yue-CN": "yue-Hans-CN",
+	"zh-HK":  "zh-Hant-HK",
+	"zh-MO":  "zh-Hant-MO",
+	"zh-TW":  "zh-Hant-TW",
+
+	// Language and script
+	"az-Arab":  "az-Arab-IR",
+	"mn-Mong":  "mn-Mong-CN",
+	"pa-Arab":  "pa-Arab-PK",
+	"uz-Arab":  "uz-Arab-AF",
+	"uz-Cyrl":  "uz-Cyrl-UZ",
+	"yue-Hans": "yue-Hans-CN",
+	"zh-Hant":  "zh-Hant-TW",
+
+	// Script only
+	"und-Arab": "ar-Arab-EG",
+	"und-Armn": "hy-Armn-AM",
+	"und-Beng": "bn-Beng-BD",
+	"und-Cyrl": "ru-Cyrl-RU",
+	"und-Deva": "hi-Deva-IN",
+	"und-Ethi": "am-Ethi-ET",
+	"und-Geor": "ka-Geor-GE",
+	"und-Grek": "el-Grek-GR",
+	"und-Hang": "ko-Hang-KR",
+	"und-Hans": "zh-Hans-CN",
+	"und-Hant": "zh-Hant-TW",
+	"und-Hebr": "he-Hebr-IL",
+	"und-Hira": "ja-Hira-JP",
+	"und-Jpan": "ja-Jpan-JP",
+	"und-Kana": "ja-Kana-JP",
+	"und-Kore": "ko-Kore-KR",
+	"und-Latn": "en-Latn-US",
+	"und-Thai": "th-Thai-TH",
+
+	// Region only
+	"und-419": "es-Latn-419",
+	"und-AO":  "pt-Latn-AO",
+	"und-AR":  "es-Latn-AR",
+	"und-AT":  "de-Latn-AT",
+	"und-AU":  "en-Latn-AU",
+	"und-BE":  "nl-Latn-BE",
+	"und-BR":  "pt-Latn-BR",
+	"und-CA":  "en-Latn-CA",
+	"und-CH":  "de-Latn-CH",
+	"und-CN":  "zh-Hans-CN",
+	"und-DE":  "de-Latn-DE",
+	"und-DK":  "da-Latn-DK",
+	"und-EG":  "ar-Arab-EG",
+	"und-ES":  "es-Latn-ES",
+	"und-FI":  "fi-Latn-FI",
+	"und-FR":  "fr-Latn-FR",
+	"und-GB":  "en-Latn-GB",
+	"und-GR":  "el-Grek-GR",
+	"und-HK":  "zh-Hant-HK",
+	"und-ID":  "id-Latn-ID",
+	"und-IE":  "en-Latn-IE",
+	"und-IL":  "he-Hebr-IL",
+	"und-IN":  "hi-Deva-IN",
+	"und-IR":  "fa-Arab-IR",
+	"und-IT":  "it-Latn-IT",
+	"und-JP":  "ja-Jpan-JP",
+	"und-KR":  "ko-Kore-KR",
+	"und-ME":  "sr-Latn-ME",
+	"und-MO":  "zh-Hant-MO",
+	"und-MX":  "es-Latn-MX",
+	"und-MY":  "ms-Latn-MY",
+	"und-NL":  "nl-Latn-NL",
+	"und-NO":  "nb-Latn-NO",
+	"und-NZ":  "en-Latn-NZ",
+	"und-PH":  "fil-Latn-PH",
+	"und-PK":  "ur-Arab-PK",
+	"und-PL":  "pl-Latn-PL",
+	"und-PT":  "pt-Latn-PT",
+	"und-RS":  "sr-Cyrl-RS",
+	"und-RU":  "ru-Cyrl-RU",
+	"und-SA":  "ar-Arab-SA",
+	"und-SE":  "sv-Latn-SE",
+	"und-SG":  "en-Latn-SG",
+	"und-TH":  "th-Thai-TH",
+	"und-TR":  "tr-Latn-TR",
+	"und-TW":  "zh-Hant-TW",
+	"und-UA":  "uk-Cyrl-UA",
+	"und-US":  "en-Latn-US",
+	"und-VN":  "vi-Latn-VN",
+	"und-ZA":  "en-Latn-ZA",
+}
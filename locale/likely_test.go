@@ -0,0 +1,68 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestTag_Maximize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"en", "en-Latn-US"},
+		{"en-GB", "en-Latn-GB"},
+		{"zh", "zh-Hans-CN"},
+		{"zh-TW", "zh-Hant-TW"},
+		{"zh-HK", "zh-Hant-HK"},
+		{"zh-Hant", "zh-Hant-TW"},
+		{"zh-Hant-CN", "zh-Hant-CN"},
+		{"sr", "sr-Cyrl-RS"},
+		{"sr-ME", "sr-Latn-ME"},
+		{"sr-Latn", "sr-Latn-RS"},
+		{"es-419", "es-Latn-419"},
+		{"und", "en-Latn-US"},
+		{"und-Hant", "zh-Hant-TW"},
+		{"und-TW", "zh-Hant-TW"},
+		{"und-Cyrl-RS", "sr-Cyrl-RS"},
+		{"iw", "he-Hebr-IL"},
+		{"de-CH-1996-u-co-phonebk", "de-Latn-CH-1996-u-co-phonebk"},
+		{"tlh", "tlh"}, // no likely subtags data
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := MustParse(tt.input).Maximize()
+			if got.String() != tt.expected {
+				t.Errorf("Maximize(%q) = %q, expected %q", tt.input, got.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestTag_Minimize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"en-Latn-US", "en"},
+		{"en-US", "en"},
+		{"en-GB", "en-GB"},
+		{"zh-Hans-CN", "zh"},
+		{"zh-Hant-TW", "zh-TW"},
+		{"zh-Hant", "zh-TW"},
+		{"zh-Hant-HK", "zh-HK"},
+		{"sr-Cyrl-RS", "sr"},
+		{"sr-Latn-RS", "sr-Latn"},
+		{"sr-Latn-ME", "sr-ME"},
+		{"de-Latn-DE-u-co-phonebk", "de-u-co-phonebk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := MustParse(tt.input).Minimize()
+			if got.String() != tt.expected {
+				t.Errorf("Minimize(%q) = %q, expected %q", tt.input, got.String(), tt.expected)
+			}
+		})
+	}
+}
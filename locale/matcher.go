@@ -0,0 +1,275 @@
+package locale
+
+// Confidence indicates how well a supported tag matches a desired tag.
+type Confidence int
+
+const (
+	No    Confidence = iota // no acceptable match
+	Low                     // usable, but a different script or language
+	High                    // same language and script, possibly another region
+	Exact                   // identical tags
+)
+
+// String returns the name of the confidence level.
+func (c Confidence) String() string {
+	switch c {
+	case Exact:
+		return "Exact"
+	case High:
+		return "High"
+	case Low:
+		return "Low"
+	default:
+		return "No"
+	}
+}
+
+const (
+	// DefaultThreshold is the default maximum distance, exclusive, for a
+	// supported tag to be considered a match. It matches the CLDR default,
+	// so a script mismatch alone is not an acceptable match.
+	DefaultThreshold = 50
+
+	// highThreshold is the maximum distance reported as High confidence.
+	highThreshold = 10
+
+	// demotionPerDesired is added to the distance for each desired tag
+	// after the first, so earlier user preferences win ties.
+	demotionPerDesired = 5
+
+	languageMismatch = 80 // distance between unrelated languages
+	scriptMismatch   = 50 // distance between different scripts
+	regionMismatch   = 4  // distance between regions in the same cluster
+	regionCluster    = 5  // distance between regions in different clusters
+)
+
+// Matcher selects the best supported tag for a list of desired tags using
+// CLDR languageMatching distances.
+type Matcher struct {
+	supported  []Tag
+	maximized  []Tag
+	thresholds []int
+}
+
+// MatcherOption configures a Matcher.
+type MatcherOption func(*matcherOptions)
+
+type matcherOptions struct {
+	threshold     int
+	tagThresholds map[Tag]int
+}
+
+// WithThreshold sets the maximum distance, exclusive, for any supported tag
+// to match. The default is DefaultThreshold.
+func WithThreshold(distance int) MatcherOption {
+	return func(o *matcherOptions) {
+		o.threshold = distance
+	}
+}
+
+// WithTagThreshold sets the maximum distance, exclusive, for a single
+// supported tag, overriding WithThreshold. Use a low value to only serve a
+// tag for close matches, or a high value to accept it as a broad fallback.
+func WithTagThreshold(supported Tag, distance int) MatcherOption {
+	return func(o *matcherOptions) {
+		if o.tagThresholds == nil {
+			o.tagThresholds = make(map[Tag]int)
+		}
+		o.tagThresholds[Canonicalize(supported)] = distance
+	}
+}
+
+// NewMatcher creates a Matcher for the supported tags, listed in order of
+// preference. The first supported tag is returned when nothing matches.
+func NewMatcher(supported []Tag, opts ...MatcherOption) *Matcher {
+	o := matcherOptions{threshold: DefaultThreshold}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	m := &Matcher{
+		supported:  make([]Tag, len(supported)),
+		maximized:  make([]Tag, len(supported)),
+		thresholds: make([]int, len(supported)),
+	}
+	for i, s := range supported {
+		m.supported[i] = s
+		m.maximized[i] = s.Maximize()
+		m.thresholds[i] = o.threshold
+		if d, ok := o.tagThresholds[Canonicalize(s)]; ok {
+			m.thresholds[i] = d
+		}
+	}
+	return m
+}
+
+// Match returns the supported tag that best matches the desired tags,
+// which are listed in order of preference, together with its index in the
+// supported list and the match confidence. If no supported tag is within
+// its threshold, the first supported tag is returned with confidence No.
+func (m *Matcher) Match(desired ...Tag) (Tag, int, Confidence) {
+	if len(m.supported) == 0 {
+		return Tag{}, -1, No
+	}
+
+	bestIndex, bestDistance, bestConf := -1, 0, No
+	for di, d := range desired {
+		if d.IsZero() {
+			continue
+		}
+		d = Canonicalize(d)
+		dm := d.Maximize()
+		for si := range m.supported {
+			raw := distance(dm, m.maximized[si])
+			if raw >= m.thresholds[si] {
+				continue
+			}
+			total := raw + di*demotionPerDesired
+			if bestIndex >= 0 && total >= bestDistance {
+				continue
+			}
+			bestIndex, bestDistance = si, total
+			switch {
+			case d == Canonicalize(m.supported[si]):
+				bestConf = Exact
+			case raw <= highThreshold:
+				bestConf = High
+			default:
+				bestConf = Low
+			}
+		}
+	}
+
+	if bestIndex < 0 {
+		return m.supported[0], 0, No
+	}
+	return m.supported[bestIndex], bestIndex, bestConf
+}
+
+// Distance returns the CLDR language matching distance between a desired
+// and a supported tag: 0 for equivalent tags, small values for regional
+// differences and large values for different scripts or languages.
+func Distance(desired, supported Tag) int {
+	return distance(desired.Maximize(), supported.Maximize())
+}
+
+// distance computes the distance between two maximized tags.
+func distance(d, s Tag) int {
+	var dist int
+
+	if d.Language != s.Language {
+		ld, ok := languageDistance(d.Language, s.Language)
+		if !ok {
+			return languageMismatch + scriptMismatch
+		}
+		dist += ld
+	}
+
+	if d.Script != s.Script {
+		if sd, ok := scriptDistances[d.Language+"-"+d.Script+">"+s.Language+"-"+s.Script]; ok {
+			dist += sd
+		} else {
+			dist += scriptMismatch
+		}
+		// Regions are not compared across scripts
+		return dist
+	}
+
+	if d.Region != s.Region {
+		dist += regionDistance(d.Language, d.Region, s.Region)
+	}
+	return dist
+}
+
+// languageDistance returns the distance between two different languages.
+func languageDistance(desired, supported string) (int, bool) {
+	if d, ok := languageDistances[desired+">"+supported]; ok {
+		return d, true
+	}
+	return 0, false
+}
+
+// regionDistance returns the distance between two different regions for a
+// language. Regions in the same cluster, such as Latin America for Spanish,
+// are closer than regions in different clusters.
+func regionDistance(lang, desired, supported string) int {
+	clusters, ok := regionClusters[lang]
+	if !ok {
+		return regionMismatch
+	}
+	if clusters[desired] == clusters[supported] {
+		return regionMismatch
+	}
+	return regionCluster
+}
+
+// languageDistances holds CLDR languageMatch distances between closely
+// related languages, keyed by "desired>supported". Symmetric pairs are
+// listed in both directions.
+var languageDistances = map[string]int{
+	// Norwegian
+	"nb>no": 1,
+	"no>nb": 1,
+	"nn>no": 10,
+	"no>nn": 10,
+	"nn>nb": 20,
+	"nb>nn": 20,
+
+	// Bosnian, Croatian and Serbian
+	"hr>bs": 4,
+	"bs>hr": 4,
+	"sr>bs": 4,
+	"bs>sr": 4,
+
+	// Malay and Indonesian
+	"id>ms": 10,
+	"ms>id": 10,
+
+	// One-way fallbacks from regional languages to a widely understood one
+	"af>nl":  20,
+	"be>ru":  20,
+	"ca>es":  20,
+	"eu>es":  20,
+	"fy>nl":  20,
+	"gl>es":  20,
+	"gsw>de": 4,
+	"kk>ru":  20,
+	"ky>ru":  20,
+	"lb>de":  20,
+	"tt>ru":  20,
+	"uk>ru":  20,
+	"yue>zh": 10,
+}
+
+// scriptDistances holds CLDR distances between scripts of the same
+// language, keyed by "desired>supported" with language-script pairs.
+var scriptDistances = map[string]int{
+	"zh-Hant>zh-Hans": 19,
+	"zh-Hans>zh-Hant": 23,
+}
+
+// regionClusters groups regions for languages whose regional variants form
+// CLDR paradigm clusters. Regions not listed are in the "" cluster.
+var regionClusters = map[string]map[string]string{
+	// American English vs. British/International English
+	"en": {
+		"US": "US", "AS": "US", "GU": "US", "MH": "US", "MP": "US",
+		"PR": "US", "UM": "US", "VI": "US",
+	},
+	// Latin American Spanish vs. European Spanish
+	"es": regionSet("419",
+		"419", "AR", "BO", "BR", "BZ", "CL", "CO", "CR", "CU", "DO",
+		"EC", "GT", "HN", "MX", "NI", "PA", "PE", "PR", "PY", "SV",
+		"US", "UY", "VE"),
+	// Brazilian Portuguese vs. European Portuguese
+	"pt": regionSet("BR", "BR"),
+}
+
+// regionSet returns a cluster map assigning every region to name.
+func regionSet(name string, regions ...string) map[string]string {
+	m := make(map[string]string, len(regions))
+	for _, r := range regions {
+		m[r] = name
+	}
+	return m
+}
@@ -0,0 +1,91 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestMatcher_Match(t *testing.T) {
+	tests := []struct {
+		name      string
+		supported []string
+		desired   []string
+		expected  string
+		index     int
+		conf      Confidence
+	}{
+		{"exact", []string{"en", "fr", "de"}, []string{"fr"}, "fr", 1, Exact},
+		{"canonical exact", []string{"en", "he"}, []string{"iw"}, "he", 1, Exact},
+		{"region", []string{"en", "fr"}, []string{"fr-CA"}, "fr", 1, High},
+		{"norwegian", []string{"en", "no"}, []string{"nb"}, "no", 1, High},
+		{"pt-AO prefers pt-PT", []string{"en", "pt-BR", "pt-PT"}, []string{"pt-AO"}, "pt-PT", 2, High},
+		{"en-AU prefers en-GB", []string{"en-US", "en-GB"}, []string{"en-AU"}, "en-GB", 1, High},
+		{"en-PR prefers en-US", []string{"en-GB", "en-US"}, []string{"en-PR"}, "en-US", 1, High},
+		{"es-MX prefers es-419", []string{"es", "es-419"}, []string{"es-MX"}, "es-419", 1, High},
+		{"traditional to simplified", []string{"en", "zh"}, []string{"zh-TW"}, "zh", 1, Low},
+		{"traditional preferred", []string{"zh-Hans", "zh-Hant"}, []string{"zh-HK"}, "zh-Hant", 1, High},
+		{"script mismatch", []string{"en", "sr-Latn"}, []string{"sr"}, "en", 0, No},
+		{"no match", []string{"en", "fr"}, []string{"ja"}, "en", 0, No},
+		{"second preference", []string{"en", "fr"}, []string{"ja", "fr"}, "fr", 1, Exact},
+		{"first preference wins", []string{"en-US", "fr"}, []string{"en-AU", "fr"}, "en-US", 0, High},
+		{"catalan to spanish", []string{"en", "es"}, []string{"ca"}, "es", 1, Low},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			supported := make([]Tag, len(tt.supported))
+			for i, s := range tt.supported {
+				supported[i] = MustParse(s)
+			}
+			desired := make([]Tag, len(tt.desired))
+			for i, d := range tt.desired {
+				desired[i] = MustParse(d)
+			}
+
+			tag, index, conf := NewMatcher(supported).Match(desired...)
+			if tag.String() != tt.expected || index != tt.index || conf != tt.conf {
+				t.Errorf("Match(%v) = %q, %d, %v, expected %q, %d, %v",
+					tt.desired, tag.String(), index, conf, tt.expected, tt.index, tt.conf)
+			}
+		})
+	}
+}
+
+func TestMatcher_Thresholds(t *testing.T) {
+	supported := []Tag{MustParse("en"), MustParse("es")}
+
+	// A per-tag threshold can reject a distant match
+	m := NewMatcher(supported, WithTagThreshold(MustParse("es"), 10))
+	if tag, _, conf := m.Match(MustParse("ca")); tag.String() != "en" || conf != No {
+		t.Errorf("Match(ca) = %q, %v, expected 'en', No", tag.String(), conf)
+	}
+
+	// A raised threshold accepts a script mismatch
+	m = NewMatcher([]Tag{MustParse("en"), MustParse("sr-Latn")}, WithThreshold(60))
+	if tag, _, conf := m.Match(MustParse("sr")); tag.String() != "sr-Latn" || conf != Low {
+		t.Errorf("Match(sr) = %q, %v, expected 'sr-Latn', Low", tag.String(), conf)
+	}
+
+	if _, index, conf := NewMatcher(nil).Match(MustParse("en")); index != -1 || conf != No {
+		t.Errorf("empty Matcher returned %d, %v", index, conf)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	if d := Distance(MustParse("en-US"), MustParse("en")); d != 0 {
+		t.Errorf("Distance(en-US, en) = %d, expected 0", d)
+	}
+	if d := Distance(MustParse("zh-TW"), MustParse("zh")); d != 19 {
+		t.Errorf("Distance(zh-TW, zh) = %d, expected 19", d)
+	}
+	if d := Distance(MustParse("ja"), MustParse("en")); d < DefaultThreshold {
+		t.Errorf("Distance(ja, en) = %d, expected at least %d", d, DefaultThreshold)
+	}
+}
+
+func TestConfidence_String(t *testing.T) {
+	for conf, expected := range map[Confidence]string{No: "No", Low: "Low", High: "High", Exact: "Exact"} {
+		if conf.String() != expected {
+			t.Errorf("Confidence(%d).String() = %q, expected %q", conf, conf.String(), expected)
+		}
+	}
+}
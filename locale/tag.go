@@ -3,85 +3,168 @@ package locale
 
 import (
 	"errors"
+	"slices"
 	"strings"
 )
 
 // Tag represents a parsed BCP 47 language tag.
+//
+// Variants, extensions and private use subtags are kept in canonical string
+// form so that Tag remains comparable and usable as a map key. Use the
+// Variants, Extensions and PrivateUse methods to access them.
 type Tag struct {
 	Language string // ISO 639-1 or 639-3 (required, lowercase)
 	Script   string // ISO 15924 (optional, title case)
 	Region   string // ISO 3166-1 alpha-2 (optional, uppercase)
+
+	extlang    string // extended language subtags, e.g. "yue" in "zh-yue"
+	variants   string // variant subtags in input order, e.g. "rozaj-biske"
+	extensions string // extension sequences sorted by singleton, e.g. "u-ca-buddhist"
+	privateUse string // private use subtags without the "x" singleton
 }
 
 // ErrInvalidTag is returned when a BCP 47 tag cannot be parsed.
 var ErrInvalidTag = errors.New("invalid BCP 47 language tag")
 
-// Parse parses a BCP 47 language tag string.
-// Accepts formats like: "en", "en-US", "zh-Hans", "zh-Hans-CN".
+// Parse parses a BCP 47 language tag string as defined by RFC 5646.
+// Accepts formats like: "en", "en-US", "zh-Hans", "zh-Hans-CN", "de-CH-1996",
+// "sl-rozaj-biske", "en-US-u-ca-gregory" and "en-US-x-twain".
 // The input is case-insensitive; output is normalized.
-func Parse(tag string) (Tag, error) {
+// Unicode locale extension (-u-) keywords and transformed content (-t-)
+// fields are put in canonical order.
+// Tags with duplicate variants, duplicate extension singletons, empty
+// extensions or subtags out of order are rejected with ErrInvalidTag.
+// Irregular grandfathered tags such as "i-klingon" are replaced by their
+// preferred values. Pass WithCanonicalization to also replace deprecated
+// and alias subtags; see Canonicalize.
+func Parse(tag string, opts ...ParseOption) (Tag, error) {
+	var o parseOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	t, err := parse(tag)
+	if err != nil {
+		return Tag{}, err
+	}
+	if o.canonicalize {
+		t = Canonicalize(t)
+	}
+	return t, nil
+}
+
+// parse parses a BCP 47 language tag string without canonicalization.
+func parse(tag string) (Tag, error) {
 	if tag == "" {
 		return Tag{}, ErrInvalidTag
 	}
 
 	// Normalize separators: accept both - and _
-	tag = strings.ReplaceAll(tag, "_", "-")
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+	if preferred, ok := grandfatheredIrregular[tag]; ok {
+		tag = preferred
+	}
 	parts := strings.Split(tag, "-")
 
-	if len(parts) == 0 || len(parts[0]) < 2 {
-		return Tag{}, ErrInvalidTag
+	// Every subtag is 1-8 alphanumeric characters
+	for _, part := range parts {
+		if len(part) == 0 || len(part) > 8 || !isAlphaNum(part) {
+			return Tag{}, ErrInvalidTag
+		}
 	}
 
+	// Validate language: 2-3 letters
+	if len(parts[0]) < 2 || len(parts[0]) > 3 || !isAlpha(parts[0]) {
+		return Tag{}, ErrInvalidTag
+	}
 	t := Tag{
-		Language: strings.ToLower(parts[0]),
+		Language: parts[0],
 	}
+	i := 1
 
-	// Validate language: 2-3 letters
-	if len(t.Language) < 2 || len(t.Language) > 3 {
-		return Tag{}, ErrInvalidTag
+	// Extended language: up to three 3-letter subtags (e.g., "zh-yue")
+	var extlang []string
+	for i < len(parts) && len(extlang) < 3 && len(parts[i]) == 3 && isAlpha(parts[i]) {
+		extlang = append(extlang, parts[i])
+		i++
+	}
+
+	// Script: 4 letters (e.g., "Hans", "Latn")
+	if i < len(parts) && len(parts[i]) == 4 && isAlpha(parts[i]) {
+		t.Script = titleCase(parts[i])
+		i++
 	}
-	for _, c := range t.Language {
-		if c < 'a' || c > 'z' {
+
+	// Region: 2 letters (ISO 3166-1) or 3 digits (UN M.49)
+	if i < len(parts) && ((len(parts[i]) == 2 && isAlpha(parts[i])) || (len(parts[i]) == 3 && isDigit(parts[i]))) {
+		t.Region = strings.ToUpper(parts[i])
+		i++
+	}
+
+	// Variants: 5-8 alphanumerics, or a digit followed by 3 alphanumerics
+	var variants []string
+	for i < len(parts) && isVariant(parts[i]) {
+		if slices.Contains(variants, parts[i]) {
 			return Tag{}, ErrInvalidTag
 		}
+		variants = append(variants, parts[i])
+		i++
 	}
 
-	// Parse remaining parts
-	for i := 1; i < len(parts); i++ {
-		part := parts[i]
-		if len(part) == 0 {
-			continue
+	// Extensions: a singleton followed by one or more 2-8 character subtags
+	var exts []Extension
+	for i < len(parts) && len(parts[i]) == 1 && parts[i] != "x" {
+		singleton := parts[i][0]
+		for _, e := range exts {
+			if e.Singleton == singleton {
+				return Tag{}, ErrInvalidTag
+			}
 		}
-
-		// Script: 4 letters (e.g., "Hans", "Latn")
-		if len(part) == 4 && t.Script == "" {
-			t.Script = titleCase(part)
-			continue
+		i++
+		start := i
+		for i < len(parts) && len(parts[i]) >= 2 {
+			i++
 		}
-
-		// Region: 2 letters (ISO 3166-1) or 3 digits (UN M.49)
-		if len(part) == 2 && t.Region == "" {
-			t.Region = strings.ToUpper(part)
-			continue
+		if i == start {
+			return Tag{}, ErrInvalidTag
 		}
-		if len(part) == 3 && t.Region == "" {
-			// Check if it's all digits (UN M.49 code)
-			allDigits := true
-			for _, c := range part {
-				if c < '0' || c > '9' {
-					allDigits = false
-					break
-				}
+		e := Extension{Singleton: singleton, Subtags: parts[start:i]}
+		switch singleton {
+		case 'u':
+			u, err := parseUnicodeExtension(e.Subtags)
+			if err != nil {
+				return Tag{}, err
 			}
-			if allDigits {
-				t.Region = part
-				continue
+			e.Subtags = u.subtags()
+		case 't':
+			tc, err := parseTransformedContent(e.Subtags)
+			if err != nil {
+				return Tag{}, err
 			}
+			e.Subtags = tc.subtags()
+		}
+		exts = append(exts, e)
+	}
+
+	// Private use: "x" followed by one or more 1-8 character subtags
+	if i < len(parts) && parts[i] == "x" {
+		i++
+		if i == len(parts) {
+			return Tag{}, ErrInvalidTag
 		}
+		t.privateUse = strings.Join(parts[i:], "-")
+		i = len(parts)
+	}
 
-		// Ignore other subtags (variants, extensions, private use)
+	// Anything left over is a subtag in the wrong position
+	if i != len(parts) {
+		return Tag{}, ErrInvalidTag
 	}
 
+	t.extlang = strings.Join(extlang, "-")
+	t.variants = strings.Join(variants, "-")
+	t.extensions = joinExtensions(exts)
+
 	return t, nil
 }
 
@@ -94,6 +177,11 @@ func (t Tag) String() string {
 	var sb strings.Builder
 	sb.WriteString(t.Language)
 
+	if t.extlang != "" {
+		sb.WriteString("-")
+		sb.WriteString(t.extlang)
+	}
+
 	if t.Script != "" {
 		sb.WriteString("-")
 		sb.WriteString(t.Script)
@@ -104,19 +192,57 @@ func (t Tag) String() string {
 		sb.WriteString(t.Region)
 	}
 
+	for _, s := range []string{t.variants, t.extensions} {
+		if s != "" {
+			sb.WriteString("-")
+			sb.WriteString(s)
+		}
+	}
+
+	if t.privateUse != "" {
+		sb.WriteString("-x-")
+		sb.WriteString(t.privateUse)
+	}
+
 	return sb.String()
 }
 
-// Parent returns the parent tag for fallback.
-// For "fr-CA" returns "fr", for "zh-Hans-CN" returns "zh-Hans", for "en" returns empty Tag.
+// Parent returns the parent tag for fallback, removing subtags one at a
+// time from the end as in RFC 4647 lookup.
+// For "fr-CA" returns "fr", for "zh-Hans-CN" returns "zh-Hans", for
+// "zh-yue" returns "zh", for "en" returns empty Tag.
+// Extensions and private use subtags are removed first, then variants one
+// by one, so "de-CH-1996-x-foo" returns "de-CH-1996" and then "de-CH".
 func (t Tag) Parent() Tag {
-	if t.Region != "" {
-		return Tag{Language: t.Language, Script: t.Script}
+	switch {
+	case t.extensions != "" || t.privateUse != "":
+		t.extensions, t.privateUse = "", ""
+	case t.variants != "":
+		t.variants = trimLastSubtag(t.variants)
+	case t.Region != "":
+		t.Region = ""
+	case t.Script != "":
+		t.Script = ""
+	case t.extlang != "":
+		t.extlang = trimLastSubtag(t.extlang)
+	default:
+		return Tag{}
 	}
-	if t.Script != "" {
-		return Tag{Language: t.Language}
+	return t
+}
+
+// trimLastSubtag removes the last subtag of a hyphen-separated sequence.
+func trimLastSubtag(s string) string {
+	if i := strings.LastIndexByte(s, '-'); i >= 0 {
+		return s[:i]
 	}
-	return Tag{}
+	return ""
+}
+
+// Base returns the tag with only its language, extended language, script
+// and region subtags. For "en-US-u-ca-gregory-x-twain" returns "en-US".
+func (t Tag) Base() Tag {
+	return Tag{Language: t.Language, Script: t.Script, Region: t.Region, extlang: t.extlang}
 }
 
 // IsZero returns true if the tag is empty/unset.
@@ -126,8 +252,8 @@ func (t Tag) IsZero() bool {
 
 // MustParse parses a BCP 47 tag and panics on error.
 // Use for known-good tags in initialization code.
-func MustParse(tag string) Tag {
-	t, err := Parse(tag)
+func MustParse(tag string, opts ...ParseOption) Tag {
+	t, err := Parse(tag, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -143,3 +269,41 @@ func titleCase(s string) string {
 	lower := strings.ToLower(s)
 	return strings.ToUpper(lower[:1]) + lower[1:]
 }
+
+// isAlpha reports whether s consists only of ASCII letters.
+func isAlpha(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') {
+			return false
+		}
+	}
+	return true
+}
+
+// isDigit reports whether s consists only of ASCII digits.
+func isDigit(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// isAlphaNum reports whether s consists only of ASCII letters and digits.
+func isAlphaNum(s string) bool {
+	for _, c := range s {
+		if (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// isVariant reports whether s is a syntactically valid variant subtag.
+func isVariant(s string) bool {
+	if len(s) >= 5 && len(s) <= 8 {
+		return isAlphaNum(s)
+	}
+	return len(s) == 4 && s[0] >= '0' && s[0] <= '9' && isAlphaNum(s[1:])
+}
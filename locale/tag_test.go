@@ -1,6 +1,7 @@
 package locale
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -103,3 +104,123 @@ func TestTag_Parent(t *testing.T) {
 		})
 	}
 }
+
+func TestParse_RoundTrip(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		// Variants
+		{"de-CH-1996", "de-CH-1996"},
+		{"sl-rozaj-biske", "sl-rozaj-biske"},
+		{"sl-IT-nedis", "sl-IT-nedis"},
+		{"de-1901", "de-1901"},
+		{"en-US-POSIX", "en-US-posix"},
+
+		// Extended language
+		{"zh-yue-HK", "zh-yue-HK"},
+
+		// Extensions, sorted by singleton
+		{"en-US-u-ca-gregory", "en-US-u-ca-gregory"},
+		{"ja-t-it", "ja-t-it"},
+		{"en-u-nu-latn-a-bbb", "en-a-bbb-u-nu-latn"},
+		{"de-DE-1901-u-co-phonebk", "de-DE-1901-u-co-phonebk"},
+
+		// Private use
+		{"en-US-x-twain", "en-US-x-twain"},
+		{"en-x-a-b", "en-x-a-b"},
+		{"sr-Latn-RS-u-nu-latn-x-private", "sr-Latn-RS-u-nu-latn-x-private"},
+
+		// Case normalization
+		{"SL-Rozaj-BISKE", "sl-rozaj-biske"},
+		{"EN-us-X-TWAIN", "en-US-x-twain"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("Parse(%q).String() = %q, expected %q", tt.input, got.String(), tt.expected)
+			}
+			again, err := Parse(got.String())
+			if err != nil || again != got {
+				t.Errorf("Parse(%q) did not round-trip: %+v, %v", got.String(), again, err)
+			}
+		})
+	}
+}
+
+func TestParse_Malformed(t *testing.T) {
+	tests := []string{
+		"sl-rozaj-rozaj",       // duplicate variant
+		"en-u-ca-gregory-u-nu", // duplicate singleton
+		"en-u",                 // empty extension
+		"en-u-x-twain",         // empty extension before private use
+		"en-x",                 // empty private use
+		"en-US-foo",            // subtag out of place
+		"en-US-Latn",           // script after region
+		"en--US",               // empty subtag
+		"en-US-",               // trailing separator
+		"en-toolongsubtag",     // subtag longer than 8 characters
+		"en-US-u-ca!",          // invalid character
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if got, err := Parse(input); err == nil {
+				t.Errorf("Parse(%q) = %q, expected error", input, got.String())
+			}
+		})
+	}
+}
+
+func TestTag_Subtags(t *testing.T) {
+	tag := MustParse("sl-Latn-IT-rozaj-biske-u-ca-gregory-t-en-x-foo-bar")
+
+	if got := tag.Variants(); !reflect.DeepEqual(got, []string{"rozaj", "biske"}) {
+		t.Errorf("Variants() = %v", got)
+	}
+	if got := tag.PrivateUse(); !reflect.DeepEqual(got, []string{"foo", "bar"}) {
+		t.Errorf("PrivateUse() = %v", got)
+	}
+
+	exts := tag.Extensions()
+	if len(exts) != 2 || exts[0].String() != "t-en" || exts[1].String() != "u-ca-gregory" {
+		t.Errorf("Extensions() = %v", exts)
+	}
+
+	e, ok := tag.Extension('U')
+	if !ok || !reflect.DeepEqual(e.Subtags, []string{"ca", "gregory"}) {
+		t.Errorf("Extension('U') = %v, %v", e, ok)
+	}
+	if _, ok := tag.Extension('a'); ok {
+		t.Error("Extension('a') should not be found")
+	}
+
+	if got := tag.Base().String(); got != "sl-Latn-IT" {
+		t.Errorf("Base() = %q, expected %q", got, "sl-Latn-IT")
+	}
+	if got := MustParse("zh-yue").ExtLangs(); !reflect.DeepEqual(got, []string{"yue"}) {
+		t.Errorf("ExtLangs() = %v", got)
+	}
+}
+
+func TestTag_Parent_Subtags(t *testing.T) {
+	for _, chain := range [][]string{
+		{"de-CH-1996-x-foo", "de-CH-1996", "de-CH", "de", ""},
+		{"sl-IT-rozaj-biske-u-ca-gregory", "sl-IT-rozaj-biske", "sl-IT-rozaj", "sl-IT", "sl", ""},
+		{"zh-yue", "zh", ""},
+		{"zh-yue-Hant-HK", "zh-yue-Hant", "zh-yue", "zh", ""},
+	} {
+		tag := MustParse(chain[0])
+		for _, expected := range chain[1:] {
+			tag = tag.Parent()
+			if tag.String() != expected {
+				t.Errorf("Parent() in chain of %q = %q, expected %q", chain[0], tag.String(), expected)
+			}
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package locale
+
+import (
+	"slices"
+	"strings"
+)
+
+// Transformed content (-t-) field keys defined by RFC 6497 and UTS #35.
+const (
+	TransformKeyMechanism   = "m0" // transform mechanism, e.g. "ungegn"
+	TransformKeySource      = "s0" // source of the transform, e.g. "accents"
+	TransformKeyDestination = "d0" // destination of the transform, e.g. "ascii"
+	TransformKeyInput       = "i0" // input method, e.g. "handwrit"
+	TransformKeyKeyboard    = "k0" // keyboard layout, e.g. "dvorak"
+	TransformKeyMachine     = "t0" // machine translation engine, e.g. "und"
+	TransformKeyHybrid      = "h0" // hybrid locale, e.g. "hybrid"
+	TransformKeyExtended    = "x0" // private use, e.g. "foo"
+)
+
+// TransformField is a single -t- field, e.g. {Key: "m0", Value: "ungegn"}.
+type TransformField struct {
+	Key   string // 2-character key, a letter followed by a digit (lowercase)
+	Value string // "-"-joined value subtags (lowercase)
+}
+
+// TransformedContent represents the RFC 6497 transformed content (-t-)
+// extension, which identifies content that was transformed from another
+// language tag, for example by transliteration or machine translation.
+type TransformedContent struct {
+	Source Tag              // source language tag, zero if absent
+	Fields []TransformField // fields sorted by key
+}
+
+// String returns the transformed content in canonical extension form,
+// e.g. "t-und-cyrl-m0-ungegn". Returns an empty string if there is
+// neither a source nor any fields.
+func (tc TransformedContent) String() string {
+	subtags := tc.subtags()
+	if len(subtags) == 0 {
+		return ""
+	}
+	return "t-" + strings.Join(subtags, "-")
+}
+
+// Field returns the value for a field key, or an empty string if absent.
+func (tc TransformedContent) Field(key string) string {
+	key = strings.ToLower(key)
+	for _, f := range tc.Fields {
+		if f.Key == key {
+			return f.Value
+		}
+	}
+	return ""
+}
+
+// subtags returns the canonical subtags of the extension. The source tag
+// is lowercased as required by RFC 6497.
+func (tc TransformedContent) subtags() []string {
+	var parts []string
+	if !tc.Source.IsZero() {
+		parts = append(parts, strings.Split(strings.ToLower(tc.Source.String()), "-")...)
+	}
+	for _, f := range tc.Fields {
+		parts = append(parts, f.Key)
+		parts = append(parts, strings.Split(f.Value, "-")...)
+	}
+	return parts
+}
+
+// parseTransformedContent parses the subtags of a -t- extension.
+// Fields are sorted by key; duplicate keys, fields without values and
+// source tags with extensions are rejected.
+func parseTransformedContent(subtags []string) (TransformedContent, error) {
+	var tc TransformedContent
+	i := 0
+
+	// Source language tag: everything before the first field key
+	for i < len(subtags) && !isTransformKey(subtags[i]) {
+		i++
+	}
+	if i > 0 {
+		src, err := Parse(strings.Join(subtags[:i], "-"))
+		if err != nil || src.extensions != "" || src.privateUse != "" {
+			return TransformedContent{}, ErrInvalidTag
+		}
+		tc.Source = src
+	}
+
+	// Fields: a key followed by one or more 3-8 character values
+	for i < len(subtags) {
+		key := subtags[i]
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 3 {
+			i++
+		}
+		if i == start || !isTransformKey(key) || tc.Field(key) != "" {
+			return TransformedContent{}, ErrInvalidTag
+		}
+		tc.Fields = append(tc.Fields, TransformField{Key: key, Value: strings.Join(subtags[start:i], "-")})
+	}
+
+	sortTransformFields(tc.Fields)
+	return tc, nil
+}
+
+// isTransformKey reports whether s is a valid -t- field key: a letter
+// followed by a digit.
+func isTransformKey(s string) bool {
+	return len(s) == 2 && isAlpha(s[:1]) && isDigit(s[1:])
+}
+
+// sortTransformFields sorts fields by key.
+func sortTransformFields(fields []TransformField) {
+	slices.SortFunc(fields, func(a, b TransformField) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+}
+
+// TransformedContent returns the -t- extension of the tag. The boolean is
+// false if the tag has no -t- extension.
+func (t Tag) TransformedContent() (TransformedContent, bool) {
+	e, ok := t.Extension('t')
+	if !ok {
+		return TransformedContent{}, false
+	}
+	// Subtags were validated by Parse, so the error can be ignored.
+	tc, _ := parseTransformedContent(e.Subtags)
+	return tc, true
+}
+
+// WithTransformedContent returns a copy of the tag with the -t- extension
+// replaced by tc. An empty TransformedContent removes the extension.
+func (t Tag) WithTransformedContent(tc TransformedContent) (Tag, error) {
+	tc.Fields = slices.Clone(tc.Fields)
+	for i := range tc.Fields {
+		tc.Fields[i].Key = strings.ToLower(tc.Fields[i].Key)
+		tc.Fields[i].Value = strings.ToLower(tc.Fields[i].Value)
+		if !isTransformKey(tc.Fields[i].Key) {
+			return Tag{}, ErrInvalidTag
+		}
+	}
+	sortTransformFields(tc.Fields)
+
+	// Round-trip through the parser to validate the source and fields
+	subtags := tc.subtags()
+	for _, s := range subtags {
+		if len(s) < 2 || len(s) > 8 || !isAlphaNum(s) {
+			return Tag{}, ErrInvalidTag
+		}
+	}
+	if _, err := parseTransformedContent(subtags); err != nil {
+		return Tag{}, err
+	}
+	return t.withExtension(Extension{Singleton: 't', Subtags: subtags}), nil
+}
@@ -0,0 +1,96 @@
+package locale
+
+import (
+	"testing"
+)
+
+func TestParse_TransformedContent(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		source   string
+	}{
+		{"ja-t-it", "ja-t-it", "it"},
+		{"und-Latn-t-und-cyrl", "und-Latn-t-und-cyrl", "und-Cyrl"},
+		{"und-Cyrl-t-und-latn-m0-ungegn-2007", "und-Cyrl-t-und-latn-m0-ungegn-2007", "und-Latn"},
+		{"en-t-s0-accents-d0-ascii", "en-t-d0-ascii-s0-accents", ""},
+		{"de-t-en-US-t0-und", "de-t-en-us-t0-und", "en-US"},
+		{"ja-t-it-u-ca-japanese", "ja-t-it-u-ca-japanese", "it"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("Parse(%q).String() = %q, expected %q", tt.input, got.String(), tt.expected)
+			}
+			tc, ok := got.TransformedContent()
+			if !ok {
+				t.Fatalf("TransformedContent() not found for %q", tt.input)
+			}
+			if tc.Source.String() != tt.source {
+				t.Errorf("TransformedContent().Source = %q, expected %q", tc.Source.String(), tt.source)
+			}
+		})
+	}
+}
+
+func TestParse_TransformedContent_Malformed(t *testing.T) {
+	tests := []string{
+		"ja-t-m0",                  // field without value
+		"ja-t-m0-ungegn-m0-alaloc", // duplicate field
+		"ja-t-it-us-us",            // invalid source tag
+		"ja-t-m0-ab",               // value too short
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if got, err := Parse(input); err == nil {
+				t.Errorf("Parse(%q) = %q, expected error", input, got.String())
+			}
+		})
+	}
+}
+
+func TestTransformedContent_Field(t *testing.T) {
+	tc, _ := MustParse("und-Cyrl-t-und-latn-m0-ungegn-2007").TransformedContent()
+	if got := tc.Field(TransformKeyMechanism); got != "ungegn-2007" {
+		t.Errorf("Field(m0) = %q, expected 'ungegn-2007'", got)
+	}
+	if got := tc.Field("s0"); got != "" {
+		t.Errorf("Field(s0) = %q, expected empty", got)
+	}
+	if _, ok := MustParse("ja").TransformedContent(); ok {
+		t.Error("TransformedContent() should not be found for 'ja'")
+	}
+}
+
+func TestTag_WithTransformedContent(t *testing.T) {
+	tag, err := MustParse("ja-x-mt").WithTransformedContent(TransformedContent{
+		Source: MustParse("en-US"),
+		Fields: []TransformField{
+			{Key: "T0", Value: "und"},
+			{Key: "m0", Value: "ungegn"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("WithTransformedContent error = %v", err)
+	}
+	if got, expected := tag.String(), "ja-t-en-us-m0-ungegn-t0-und-x-mt"; got != expected {
+		t.Errorf("String() = %q, expected %q", got, expected)
+	}
+
+	tag, err = tag.WithTransformedContent(TransformedContent{})
+	if err != nil || tag.String() != "ja-x-mt" {
+		t.Errorf("WithTransformedContent(empty) = %q, %v, expected 'ja-x-mt'", tag.String(), err)
+	}
+
+	if _, err := tag.WithTransformedContent(TransformedContent{
+		Fields: []TransformField{{Key: "zz", Value: "und"}},
+	}); err == nil {
+		t.Error("expected error for invalid field key")
+	}
+}
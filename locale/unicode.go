@@ -0,0 +1,268 @@
+package locale
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Unicode locale extension (-u-) keys defined by UTS #35.
+const (
+	KeyCalendar          = "ca" // calendar algorithm, e.g. "gregory", "buddhist"
+	KeyCollation         = "co" // collation type, e.g. "phonebk"
+	KeyCurrency          = "cu" // ISO 4217 currency code, e.g. "eur"
+	KeyFirstWeekday      = "fw" // first day of the week, e.g. "mon"
+	KeyHourCycle         = "hc" // hour cycle, e.g. "h23"
+	KeyMeasurementSystem = "ms" // measurement system, e.g. "metric"
+	KeyNumberingSystem   = "nu" // numbering system, e.g. "latn", "arab"
+	KeyRegionOverride    = "rg" // region override, e.g. "gbzzzz"
+	KeyTimeZone          = "tz" // BCP 47 time zone, e.g. "usnyc"
+)
+
+// HourCycle represents the "hc" Unicode locale extension value.
+type HourCycle string
+
+const (
+	HourCycleH11 HourCycle = "h11" // 0-11, with AM/PM
+	HourCycleH12 HourCycle = "h12" // 1-12, with AM/PM
+	HourCycleH23 HourCycle = "h23" // 0-23
+	HourCycleH24 HourCycle = "h24" // 1-24
+)
+
+// MeasurementSystem represents the "ms" Unicode locale extension value.
+type MeasurementSystem string
+
+const (
+	MeasurementMetric   MeasurementSystem = "metric"
+	MeasurementUKSystem MeasurementSystem = "uksystem"
+	MeasurementUSSystem MeasurementSystem = "ussystem"
+)
+
+// Keyword is a single Unicode locale extension key/type pair, e.g.
+// {Key: "ca", Type: "islamic-civil"}.
+type Keyword struct {
+	Key  string // 2-character key (lowercase)
+	Type string // "-"-joined type subtags (lowercase), empty for "true"
+}
+
+// unicodeExtension is the parsed content of a -u- extension.
+type unicodeExtension struct {
+	attributes []string
+	keywords   []Keyword
+}
+
+// parseUnicodeExtension parses the subtags of a -u- extension.
+// Attributes are sorted and deduplicated, keywords are sorted by key,
+// later duplicate keys are ignored and "true" types are dropped, following
+// the UTS #35 canonicalization rules.
+func parseUnicodeExtension(subtags []string) (unicodeExtension, error) {
+	var u unicodeExtension
+	i := 0
+
+	// Attributes: 3-8 alphanumerics before the first key
+	for i < len(subtags) && len(subtags[i]) >= 3 {
+		if !slices.Contains(u.attributes, subtags[i]) {
+			u.attributes = append(u.attributes, subtags[i])
+		}
+		i++
+	}
+
+	// Keywords: a 2-character key followed by zero or more 3-8 character types
+	for i < len(subtags) {
+		key := subtags[i]
+		if !isUnicodeKey(key) {
+			return unicodeExtension{}, ErrInvalidTag
+		}
+		i++
+		start := i
+		for i < len(subtags) && len(subtags[i]) >= 3 {
+			i++
+		}
+		typ := strings.Join(subtags[start:i], "-")
+		if typ == "true" {
+			typ = ""
+		}
+		if u.index(key) < 0 {
+			u.keywords = append(u.keywords, Keyword{Key: key, Type: typ})
+		}
+	}
+
+	slices.Sort(u.attributes)
+	u.sortKeywords()
+	return u, nil
+}
+
+// subtags returns the canonical subtags of the extension.
+func (u unicodeExtension) subtags() []string {
+	parts := slices.Clone(u.attributes)
+	for _, kw := range u.keywords {
+		parts = append(parts, kw.Key)
+		if kw.Type != "" {
+			parts = append(parts, strings.Split(kw.Type, "-")...)
+		}
+	}
+	return parts
+}
+
+// index returns the position of key in the keyword list, or -1.
+func (u unicodeExtension) index(key string) int {
+	return slices.IndexFunc(u.keywords, func(kw Keyword) bool { return kw.Key == key })
+}
+
+// sortKeywords sorts keywords by key.
+func (u unicodeExtension) sortKeywords() {
+	slices.SortFunc(u.keywords, func(a, b Keyword) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+}
+
+// isUnicodeKey reports whether s is a valid -u- key: an alphanumeric
+// followed by a letter.
+func isUnicodeKey(s string) bool {
+	return len(s) == 2 && isAlphaNum(s[:1]) && isAlpha(s[1:])
+}
+
+// unicodeExtension returns the parsed -u- extension of the tag.
+func (t Tag) unicodeExtension() unicodeExtension {
+	e, ok := t.Extension('u')
+	if !ok {
+		return unicodeExtension{}
+	}
+	// Subtags were validated by Parse, so the error can be ignored.
+	u, _ := parseUnicodeExtension(e.Subtags)
+	return u
+}
+
+// UnicodeKeywords returns the Unicode locale extension keywords of the tag
+// ordered by key.
+func (t Tag) UnicodeKeywords() []Keyword {
+	return t.unicodeExtension().keywords
+}
+
+// UnicodeKeyword returns the type for a Unicode locale extension key,
+// e.g. "gregory" for key "ca" in "en-u-ca-gregory". Returns an empty
+// string if the key is not present; a key without a type reports "true".
+func (t Tag) UnicodeKeyword(key string) string {
+	u := t.unicodeExtension()
+	i := u.index(strings.ToLower(key))
+	if i < 0 {
+		return ""
+	}
+	if u.keywords[i].Type == "" {
+		return "true"
+	}
+	return u.keywords[i].Type
+}
+
+// WithUnicodeKeyword returns a copy of the tag with the Unicode locale
+// extension key set to the given type. Multi-subtag types are "-"-joined,
+// e.g. "islamic-civil". An empty type removes the key.
+func (t Tag) WithUnicodeKeyword(key, typ string) (Tag, error) {
+	key = strings.ToLower(key)
+	typ = strings.ToLower(typ)
+	if !isUnicodeKey(key) {
+		return Tag{}, ErrInvalidTag
+	}
+	if typ == "" {
+		return t.WithoutUnicodeKeyword(key), nil
+	}
+	if typ == "true" {
+		typ = ""
+	}
+	for _, s := range splitSubtags(typ) {
+		if len(s) < 3 || len(s) > 8 || !isAlphaNum(s) {
+			return Tag{}, ErrInvalidTag
+		}
+	}
+
+	u := t.unicodeExtension()
+	if i := u.index(key); i >= 0 {
+		u.keywords[i].Type = typ
+	} else {
+		u.keywords = append(u.keywords, Keyword{Key: key, Type: typ})
+		u.sortKeywords()
+	}
+	return t.withExtension(Extension{Singleton: 'u', Subtags: u.subtags()}), nil
+}
+
+// WithoutUnicodeKeyword returns a copy of the tag with the Unicode locale
+// extension key removed. The -u- extension is dropped once it is empty.
+func (t Tag) WithoutUnicodeKeyword(key string) Tag {
+	u := t.unicodeExtension()
+	i := u.index(strings.ToLower(key))
+	if i < 0 {
+		return t
+	}
+	u.keywords = slices.Delete(u.keywords, i, i+1)
+	return t.withExtension(Extension{Singleton: 'u', Subtags: u.subtags()})
+}
+
+// Calendar returns the "ca" calendar keyword, e.g. "gregory".
+func (t Tag) Calendar() string {
+	return t.UnicodeKeyword(KeyCalendar)
+}
+
+// Collation returns the "co" collation keyword, e.g. "phonebk".
+func (t Tag) Collation() string {
+	return t.UnicodeKeyword(KeyCollation)
+}
+
+// Currency returns the "cu" currency keyword as an uppercase ISO 4217
+// code, e.g. "EUR".
+func (t Tag) Currency() string {
+	return strings.ToUpper(t.UnicodeKeyword(KeyCurrency))
+}
+
+// NumberingSystem returns the "nu" numbering system keyword, e.g. "latn".
+func (t Tag) NumberingSystem() string {
+	return t.UnicodeKeyword(KeyNumberingSystem)
+}
+
+// TimeZone returns the "tz" BCP 47 time zone keyword, e.g. "usnyc".
+func (t Tag) TimeZone() string {
+	return t.UnicodeKeyword(KeyTimeZone)
+}
+
+// HourCycle returns the "hc" hour cycle keyword, or an empty HourCycle if
+// the keyword is absent.
+func (t Tag) HourCycle() HourCycle {
+	return HourCycle(t.UnicodeKeyword(KeyHourCycle))
+}
+
+// MeasurementSystem returns the "ms" measurement system keyword, or an
+// empty MeasurementSystem if the keyword is absent.
+func (t Tag) MeasurementSystem() MeasurementSystem {
+	return MeasurementSystem(t.UnicodeKeyword(KeyMeasurementSystem))
+}
+
+// weekdayTypes maps "fw" keyword types to weekdays.
+var weekdayTypes = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// FirstWeekday returns the "fw" first day of the week keyword.
+// The boolean is false if the keyword is absent or not a valid weekday.
+func (t Tag) FirstWeekday() (time.Weekday, bool) {
+	wd, ok := weekdayTypes[t.UnicodeKeyword(KeyFirstWeekday)]
+	return wd, ok
+}
+
+// RegionOverride returns the region from the "rg" keyword, e.g. "GB" for
+// "en-US-u-rg-gbzzzz" or "US" for the subdivision "usca". Returns an empty
+// string if the keyword is absent or malformed.
+func (t Tag) RegionOverride() string {
+	rg := t.UnicodeKeyword(KeyRegionOverride)
+	switch {
+	case len(rg) >= 3 && isAlpha(rg[:2]):
+		return strings.ToUpper(rg[:2])
+	case len(rg) >= 4 && isDigit(rg[:3]):
+		return rg[:3]
+	}
+	return ""
+}
@@ -0,0 +1,109 @@
+package locale
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse_UnicodeExtension(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"en-US-u-hc-h23-fw-mon", "en-US-u-fw-mon-hc-h23"},
+		{"en-u-nu-latn-ca-gregory", "en-u-ca-gregory-nu-latn"},
+		{"en-u-foo-bar-nu-latn", "en-u-bar-foo-nu-latn"},
+		{"en-u-ca-islamic-civil", "en-u-ca-islamic-civil"},
+		{"en-u-ca-true", "en-u-ca"},
+		{"en-u-ca-gregory-ca-buddhist", "en-u-ca-gregory"},
+		{"th-TH-u-nu-thai-x-foo", "th-TH-u-nu-thai-x-foo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) error = %v", tt.input, err)
+			}
+			if got.String() != tt.expected {
+				t.Errorf("Parse(%q).String() = %q, expected %q", tt.input, got.String(), tt.expected)
+			}
+		})
+	}
+
+	if _, err := Parse("en-u-ca-gregory-c1"); err == nil {
+		t.Error("expected error for invalid -u- key")
+	}
+}
+
+func TestTag_UnicodeKeywordGetters(t *testing.T) {
+	tag := MustParse("en-US-u-ca-buddhist-co-phonebk-cu-eur-fw-mon-hc-h23-ms-uksystem-nu-thai-rg-gbzzzz-tz-usnyc")
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"Calendar", tag.Calendar(), "buddhist"},
+		{"Collation", tag.Collation(), "phonebk"},
+		{"Currency", tag.Currency(), "EUR"},
+		{"HourCycle", string(tag.HourCycle()), string(HourCycleH23)},
+		{"MeasurementSystem", string(tag.MeasurementSystem()), string(MeasurementUKSystem)},
+		{"NumberingSystem", tag.NumberingSystem(), "thai"},
+		{"RegionOverride", tag.RegionOverride(), "GB"},
+		{"TimeZone", tag.TimeZone(), "usnyc"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s() = %q, expected %q", tt.name, tt.got, tt.expected)
+		}
+	}
+
+	if wd, ok := tag.FirstWeekday(); !ok || wd != time.Monday {
+		t.Errorf("FirstWeekday() = %v, %v, expected Monday", wd, ok)
+	}
+	if len(tag.UnicodeKeywords()) != 9 {
+		t.Errorf("UnicodeKeywords() = %v, expected 9 keywords", tag.UnicodeKeywords())
+	}
+
+	plain := MustParse("en-US")
+	if plain.Calendar() != "" || plain.HourCycle() != "" || plain.RegionOverride() != "" {
+		t.Error("expected empty keywords for tag without -u- extension")
+	}
+	if _, ok := plain.FirstWeekday(); ok {
+		t.Error("FirstWeekday() should not be set for en-US")
+	}
+}
+
+func TestTag_WithUnicodeKeyword(t *testing.T) {
+	tag := MustParse("en-US-x-twain")
+
+	tag, err := tag.WithUnicodeKeyword("hc", "h12")
+	if err != nil {
+		t.Fatalf("WithUnicodeKeyword error = %v", err)
+	}
+	tag, err = tag.WithUnicodeKeyword("CA", "Gregory")
+	if err != nil {
+		t.Fatalf("WithUnicodeKeyword error = %v", err)
+	}
+	if got, expected := tag.String(), "en-US-u-ca-gregory-hc-h12-x-twain"; got != expected {
+		t.Errorf("String() = %q, expected %q", got, expected)
+	}
+
+	tag, _ = tag.WithUnicodeKeyword("hc", "h23")
+	if tag.HourCycle() != HourCycleH23 {
+		t.Errorf("HourCycle() = %q, expected h23", tag.HourCycle())
+	}
+
+	tag = tag.WithoutUnicodeKeyword("ca")
+	tag, _ = tag.WithUnicodeKeyword("hc", "")
+	if got, expected := tag.String(), "en-US-x-twain"; got != expected {
+		t.Errorf("String() = %q, expected %q", got, expected)
+	}
+
+	for _, kv := range [][2]string{{"c", "x"}, {"a1", "gregory"}, {"ca", "ab"}, {"ca", "toolongtype"}} {
+		if _, err := tag.WithUnicodeKeyword(kv[0], kv[1]); err == nil {
+			t.Errorf("WithUnicodeKeyword(%q, %q) expected error", kv[0], kv[1])
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package messages
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Localizable is implemented by values that format themselves for a
+// Localizer, such as enums whose names are translated messages. A
+// Localizable value in template data is replaced by the string it returns.
+type Localizable interface {
+	Localize(l *Localizer) string
+}
+
+// structField is a template variable backed by a struct field.
+type structField struct {
+	name  string
+	index []int
+}
+
+// structFieldsCache caches the template variables of struct types.
+var structFieldsCache sync.Map // reflect.Type -> []structField
+
+// vars converts the template data passed to a Localizer into variables
+// as described by templateArgs, with Localizable values formatted for l.
+func (l *Localizer) vars(data any) (map[string]any, error) {
+	args, err := templateArgs(data)
+	return l.localizeVars(args), err
+}
+
+// templateData is the data a translation is rendered with: the caller's
+// variables plus up to two variables set by the Localizer, such as Count,
+// which take precedence. It avoids copying the caller's map on every call.
+type templateData struct {
+	vars   map[string]any
+	names  [2]string
+	values [2]any
+	n      int
+}
+
+// with returns d with a variable set.
+func (d templateData) with(name string, value any) templateData {
+	d.names[d.n], d.values[d.n] = name, value
+	d.n++
+	return d
+}
+
+// lookup returns the value of a variable as lookupVar does, preferring
+// variables set by the Localizer.
+func (d *templateData) lookup(name string) (any, bool) {
+	for i := range d.n {
+		if d.names[i] == name {
+			return d.values[i], true
+		}
+	}
+	if v, ok := d.vars[name]; ok {
+		return v, true
+	}
+	for i := range d.n {
+		if strings.EqualFold(d.names[i], name) {
+			return d.values[i], true
+		}
+	}
+	return lookupVar(d.vars, name)
+}
+
+// asMap returns the variables as one map, copying the caller's map only if
+// the Localizer set any.
+func (d *templateData) asMap() map[string]any {
+	if d.n == 0 {
+		return d.vars
+	}
+	m := make(map[string]any, len(d.vars)+d.n)
+	maps.Copy(m, d.vars)
+	for i := range d.n {
+		m[d.names[i]] = d.values[i]
+	}
+	return m
+}
+
+// templateArgs converts the template data passed to a Localizer into
+// variables without formatting them. data may be nil, a map with string
+// keys, or a struct or pointer to one, whose exported fields are variables
+// named by their i18n struct tag or their field name. A field tagged
+// i18n:"-" is skipped, and fields of embedded structs are promoted as in
+// encoding/json. Other data returns an error wrapping ErrUnsupportedData.
+func templateArgs(data any) (map[string]any, error) {
+	switch d := data.(type) {
+	case nil:
+		return nil, nil
+	case map[string]any:
+		return d, nil
+	}
+
+	v := reflect.ValueOf(data)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+	switch {
+	case v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String:
+		args := make(map[string]any, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			args[iter.Key().String()] = iter.Value().Interface()
+		}
+		return args, nil
+	case v.Kind() == reflect.Struct:
+		fields := cachedStructFields(v.Type())
+		args := make(map[string]any, len(fields))
+		for _, f := range fields {
+			fv, err := v.FieldByIndexErr(f.index)
+			if err != nil {
+				continue // field of a nil embedded pointer
+			}
+			args[f.name] = fv.Interface()
+		}
+		return args, nil
+	}
+	return nil, fmt.Errorf("%w: %T", ErrUnsupportedData, data)
+}
+
+// localizeVars returns data with Localizable values formatted for l,
+// copying data only if it has any.
+func (l *Localizer) localizeVars(data map[string]any) map[string]any {
+	for _, v := range data {
+		if _, ok := v.(Localizable); !ok {
+			continue
+		}
+		vars := make(map[string]any, len(data))
+		for k, v := range data {
+			vars[k] = l.localizeValue(v)
+		}
+		return vars
+	}
+	return data
+}
+
+// localizeValue formats v for l if it is Localizable. A nil pointer is
+// kept as it is, for fmt to print.
+func (l *Localizer) localizeValue(v any) any {
+	if lv, ok := v.(Localizable); ok && !isNilPointer(v) {
+		return lv.Localize(l)
+	}
+	return v
+}
+
+// isNilPointer reports whether v is a nil pointer of any type.
+func isNilPointer(v any) bool {
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Pointer && rv.IsNil()
+}
+
+// cachedStructFields returns the template variables of a struct type.
+func cachedStructFields(t reflect.Type) []structField {
+	if f, ok := structFieldsCache.Load(t); ok {
+		return f.([]structField)
+	}
+	f, _ := structFieldsCache.LoadOrStore(t, structFields(t))
+	return f.([]structField)
+}
+
+// structFields lists the exported fields of a struct type that are
+// template variables, including promoted fields of embedded structs.
+func structFields(t reflect.Type) []structField {
+	var fields []structField
+	for _, f := range reflect.VisibleFields(t) {
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("i18n"), ",")
+		if name == "-" {
+			continue
+		}
+		if f.Anonymous && name == "" {
+			continue // its fields are promoted
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields = append(fields, structField{name: name, index: f.Index})
+	}
+	return fields
+}
@@ -0,0 +1,181 @@
+package messages
+
+import (
+	"errors"
+	"testing"
+)
+
+// status is a Localizable enum whose names are messages.
+type status int
+
+func (s status) String() string { return []string{"open", "closed"}[s] }
+
+func (s status) Localize(l *Localizer) string {
+	return l.T("status." + s.String())
+}
+
+// team is a fmt.Stringer.
+type team struct{ name string }
+
+func (t *team) String() string { return "team " + t.name }
+
+type audit struct {
+	Actor string `i18n:"Name"`
+}
+
+type ticket struct {
+	audit
+	Title  string
+	Status status
+	Team   *team
+	Secret string `i18n:"-"`
+	Count  int    `i18n:"Count,omitempty"`
+	note   string
+}
+
+func newArgsLocalizer(t *testing.T) *Localizer {
+	t.Helper()
+	b := NewBundle("en")
+	err := b.AddLocale("en", []byte(`{"messages": [
+		{"id": "status.open", "translation": "open"},
+		{"id": "status.closed", "translation": "closed"},
+		{"id": "ticket", "translation": "{{.Name}} marked {{.Title}} as {{.Status}} for {{.Team}}"},
+		{"id": "secret", "translation": "{{.Secret}} {{.note}}"},
+		{"id": "comments", "translation": {"one": "{{.Title}}: {{.Count}} comment", "other": "{{.Title}}: {{.Count}} comments"}},
+		{"id": "ticket_sel", "select": "Status", "translation": {"open": "{{.Title}} is open", "other": "{{.Title}} is done"}}
+	]}`))
+	if err != nil {
+		t.Fatalf("AddLocale error: %v", err)
+	}
+	return b.Localizer("en")
+}
+
+func TestLocalizer_TfStruct(t *testing.T) {
+	l := newArgsLocalizer(t)
+	tk := ticket{
+		audit:  audit{Actor: "Ana"},
+		Title:  "Login bug",
+		Status: 1,
+		Team:   &team{name: "web"},
+		Secret: "hunter2",
+		note:   "internal",
+	}
+
+	want := "Ana marked Login bug as closed for team web"
+	if got := l.Tf("ticket", tk); got != want {
+		t.Errorf("Tf(ticket, struct) = %q, want %q", got, want)
+	}
+	if got := l.Tf("ticket", &tk); got != want {
+		t.Errorf("Tf(ticket, pointer) = %q, want %q", got, want)
+	}
+
+	got, err := l.Localize("secret", tk)
+	var mv *MissingVariableError
+	if !errors.As(err, &mv) || len(mv.Names) != 2 || got != "{{.Secret}} {{.note}}" {
+		t.Errorf("Localize(secret) = %q, %v, want skipped and unexported fields missing", got, err)
+	}
+
+	if got := l.Ts("ticket_sel", ticket{Title: "Crash", Status: 0}); got != "Crash is open" {
+		t.Errorf("Ts(ticket_sel) = %q", got)
+	}
+	got, err = l.LocalizePlural("comments", 2, ticket{Title: "Crash"})
+	if err != nil || got != "Crash: 2 comments" {
+		t.Errorf("LocalizePlural(comments) = %q, %v", got, err)
+	}
+}
+
+func TestLocalizer_TsLocalizableSelect(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("fr", []byte(`{"messages": [
+		{"id": "status.open", "translation": "ouvert"},
+		{"id": "status.closed", "translation": "fermé"},
+		{"id": "ticket_sel", "select": "Status", "translation": {
+			"open": "{{.Title}} est {{.Status}}",
+			"other": "{{.Title}} est terminé"
+		}}
+	]}`))
+	l := b.Localizer("fr")
+	if got := l.Ts("ticket_sel", ticket{Title: "Crash", Status: 0}); got != "Crash est ouvert" {
+		t.Errorf("Ts(ticket_sel, open) = %q, want %q", got, "Crash est ouvert")
+	}
+	if got := l.Ts("ticket_sel", map[string]any{"Title": "Crash", "Status": status(1)}); got != "Crash est terminé" {
+		t.Errorf("Ts(ticket_sel, closed) = %q, want %q", got, "Crash est terminé")
+	}
+}
+
+func TestLocalizer_TfTypedValues(t *testing.T) {
+	l := newArgsLocalizer(t)
+	data := map[string]any{
+		"Name":   "Ana",
+		"Title":  "Login bug",
+		"Status": status(0),
+		"Team":   &team{name: "api"},
+	}
+	want := "Ana marked Login bug as open for team api"
+	if got := l.Tf("ticket", data); got != want {
+		t.Errorf("Tf(ticket, map) = %q, want %q", got, want)
+	}
+	if _, ok := data["Status"].(status); !ok {
+		t.Error("Tf modified the caller's map")
+	}
+
+	strs := map[string]string{"Name": "Bo", "Title": "Typo", "Status": "new", "Team": "docs"}
+	if got := l.Tf("ticket", strs); got != "Bo marked Typo as new for docs" {
+		t.Errorf("Tf(ticket, map[string]string) = %q", got)
+	}
+}
+
+func TestLocalizer_TfNilValues(t *testing.T) {
+	l := newArgsLocalizer(t)
+	want := "Ana marked Login bug as open for <nil>"
+	if got := l.Tf("ticket", ticket{audit: audit{Actor: "Ana"}, Title: "Login bug"}); got != want {
+		t.Errorf("Tf(ticket, nil field) = %q, want %q", got, want)
+	}
+
+	data := map[string]any{
+		"Name":   "Ana",
+		"Title":  "Login bug",
+		"Status": (*status)(nil),
+		"Team":   (*team)(nil),
+	}
+	if got := l.Tf("ticket", data); got != "Ana marked Login bug as <nil> for <nil>" {
+		t.Errorf("Tf(ticket, nil map values) = %q", got)
+	}
+}
+
+func TestLocalizer_TfUnsupportedData(t *testing.T) {
+	l := newArgsLocalizer(t)
+	got, err := l.Localize("status.open", 42)
+	if !errors.Is(err, ErrUnsupportedData) || got != "open" {
+		t.Errorf("Localize(int data) = %q, %v, want ErrUnsupportedData", got, err)
+	}
+	if got := l.Tf("ticket", (*ticket)(nil)); got != "{{.Name}} marked {{.Title}} as {{.Status}} for {{.Team}}" {
+		t.Errorf("Tf(nil pointer) = %q", got)
+	}
+}
+
+func TestLocalizer_TfStructTemplates(t *testing.T) {
+	l := newArgsLocalizer(t)
+	l.bundle.EnableTemplates()
+	tk := ticket{audit: audit{Actor: "Ana"}, Title: "Login bug", Status: 0, Team: &team{name: "web"}}
+	if got := l.Tf("ticket", tk); got != "Ana marked Login bug as open for team web" {
+		t.Errorf("Tf(ticket) with templates = %q", got)
+	}
+}
+
+func BenchmarkLocalizer_TfStruct(b *testing.B) {
+	bundle := NewBundle("en")
+	_ = bundle.AddLocale("en", []byte(`{"messages": [
+		{"id": "moved", "translation": "{{.Name}} moved {{.Count}} files to {{.Folder}}."}
+	]}`))
+	l := bundle.Localizer("en")
+	data := struct {
+		Name   string
+		Count  int
+		Folder string
+	}{"Ana", 42, "documents"}
+	b.ReportAllocs()
+	for b.Loop() {
+		l.Tf("moved", data)
+	}
+}
@@ -1,21 +1,68 @@
 package messages
 
 import (
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+
 	"github.com/grokify/structured-locale/locale"
 )
 
 // Bundle holds messages for multiple locales with fallback support.
+//
+// A Bundle is safe for concurrent use. Lookups read an immutable snapshot
+// of the loaded locales without locking; writers copy the affected data,
+// then atomically swap in a new snapshot, so a Localizer never observes a
+// partially applied update.
 type Bundle struct {
 	defaultLocale string
-	locales       map[string]*MessageSet
+
+	mu      sync.Mutex // serializes writers
+	locales atomic.Pointer[map[string]*MessageSet]
+
+	lazy      *lazyLoader // nil unless created with NewLazyBundle
+	hook      atomic.Pointer[IssueHook]
+	formats   sync.Map                       // ICU MessageFormat pattern -> *MessageFormat
+	templates atomic.Pointer[templateConfig] // nil unless EnableTemplates was called
+	parsed    sync.Map                       // templateKey -> parsedTemplate
 }
 
 // NewBundle creates a bundle with the specified default locale.
 func NewBundle(defaultLocale string) *Bundle {
-	return &Bundle{
+	b := &Bundle{
 		defaultLocale: defaultLocale,
-		locales:       make(map[string]*MessageSet),
 	}
+	b.locales.Store(&map[string]*MessageSet{})
+	return b
+}
+
+// snapshot returns the current locale map. It must not be modified.
+func (b *Bundle) snapshot() map[string]*MessageSet {
+	if p := b.locales.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// update applies fn to a copy of the locale map and publishes the result.
+// MessageSets in the copy are shared with the previous snapshot and must
+// be cloned before being modified.
+func (b *Bundle) update(fn func(locales map[string]*MessageSet)) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	locales := maps.Clone(b.snapshot())
+	if locales == nil {
+		locales = make(map[string]*MessageSet)
+	}
+	fn(locales)
+	b.locales.Store(&locales)
+
+	// Templates and formats are keyed by their source, so cached ones stay
+	// correct, but those of replaced messages would never be used again.
+	b.parsed.Clear()
+	b.formats.Clear()
 }
 
 // DefaultLocale returns the bundle's default locale.
@@ -26,84 +73,177 @@ func (b *Bundle) DefaultLocale() string {
 // AddLocale adds messages for a locale from JSON data.
 // Replaces any existing messages for this locale.
 func (b *Bundle) AddLocale(loc string, data []byte) error {
+	return b.addLocale(loc, data, false)
+}
+
+// AddLocaleOverrides merges override messages into an existing locale.
+// Only adds/updates the specified messages; others are unchanged.
+func (b *Bundle) AddLocaleOverrides(loc string, data []byte) error {
+	return b.addLocale(loc, data, true)
+}
+
+// addLocale parses JSON data and replaces or merges it into a locale.
+func (b *Bundle) addLocale(loc string, data []byte, merge bool) error {
 	mf, err := ParseMessagesJSON(data)
 	if err != nil {
 		return err
 	}
 
-	// Normalize the locale tag
-	t, err := locale.Parse(loc)
+	normalized, err := normalizeLocale(loc)
 	if err != nil {
 		return err
 	}
-	normalized := t.String()
 
-	ms := NewMessageSet(normalized)
-	for i := range mf.Messages {
-		ms.Set(&mf.Messages[i])
+	if b.lazy != nil {
+		if merge {
+			// Overrides apply on top of the loader's messages
+			_, _ = b.loadLazy(normalized)
+		}
+		b.lazy.pin(normalized)
 	}
 
-	b.locales[normalized] = ms
+	b.update(func(locales map[string]*MessageSet) {
+		setMessages(locales, normalized, mf.Messages, merge)
+	})
 	return nil
 }
 
-// AddLocaleOverrides merges override messages into an existing locale.
-// Only adds/updates the specified messages; others are unchanged.
-func (b *Bundle) AddLocaleOverrides(loc string, data []byte) error {
-	mf, err := ParseMessagesJSON(data)
-	if err != nil {
-		return err
-	}
-
-	// Normalize the locale tag
-	t, err := locale.Parse(loc)
+// normalizeLocale normalizes a locale tag for use as a bundle key.
+// Canonicalization makes aliases such as "iw" and "he" share a single entry.
+func normalizeLocale(loc string) (string, error) {
+	t, err := locale.Parse(loc, locale.WithCanonicalization())
 	if err != nil {
-		return err
+		return "", err
 	}
-	normalized := t.String()
+	return t.String(), nil
+}
 
-	ms, ok := b.locales[normalized]
-	if !ok {
-		ms = NewMessageSet(normalized)
-		b.locales[normalized] = ms
+// setMessages stores messages for a normalized locale in a locale map
+// being prepared by update, either replacing the locale's MessageSet or
+// merging into a copy of it.
+func setMessages(locales map[string]*MessageSet, normalized string, msgs []Message, merge bool) {
+	// Copy on write: readers may still hold the previous MessageSet
+	ms := NewMessageSet(normalized)
+	if prev, ok := locales[normalized]; ok && merge {
+		ms = prev.clone()
 	}
-
-	for i := range mf.Messages {
-		ms.Set(&mf.Messages[i])
+	for i := range msgs {
+		ms.Set(&msgs[i])
 	}
-
-	return nil
+	locales[normalized] = ms
 }
 
 // Localizer returns a Localizer for the specified locale with fallback.
+// The fallback chain is computed once, here, rather than on every lookup.
 func (b *Bundle) Localizer(loc string) *Localizer {
+	normalized, _ := normalizeLocale(loc)
 	return &Localizer{
-		bundle: b,
-		locale: loc,
+		bundle:     b,
+		locale:     loc,
+		normalized: normalized,
+		chain:      locale.FallbackChain(loc, b.defaultLocale),
 	}
 }
 
 // GetMessage retrieves a message by ID for the given locale.
 // Uses fallback chain if not found in the requested locale.
 func (b *Bundle) GetMessage(loc string, id string) *Message {
+	m, _, _ := b.findMessage(loc, id)
+	return m
+}
+
+// findMessage looks up a message along the fallback chain of a locale,
+// returning the message, the locale it was found in and the chain tried.
+func (b *Bundle) findMessage(loc string, id string) (*Message, string, []string) {
 	chain := locale.FallbackChain(loc, b.defaultLocale)
+	m, found := b.findInChain(chain, id)
+	return m, found, chain
+}
 
+// findInChain looks up a message in the locales of a fallback chain,
+// returning the message and the locale it was found in.
+func (b *Bundle) findInChain(chain []string, id string) (*Message, string) {
 	for _, l := range chain {
-		if ms, ok := b.locales[l]; ok {
+		if ms := b.messageSet(l); ms != nil {
 			if m := ms.Get(id); m != nil {
-				return m
+				return m, l
 			}
 		}
 	}
+	return nil, ""
+}
 
-	return nil
+// messageFormat returns the parsed MessageFormat for a pattern, caching it
+// so that each translation is parsed once.
+func (b *Bundle) messageFormat(pattern string) (*MessageFormat, error) {
+	if mf, ok := b.formats.Load(pattern); ok {
+		return mf.(*MessageFormat), nil
+	}
+	mf, err := ParseMessageFormat(pattern)
+	if err != nil {
+		return nil, err
+	}
+	b.formats.Store(pattern, mf)
+	return mf, nil
 }
 
-// AvailableLocales returns the list of loaded locales.
+// AvailableLocales returns the list of loaded locales. For a lazily
+// loading bundle, this includes the locales its Loader can provide.
 func (b *Bundle) AvailableLocales() []string {
-	result := make([]string, 0, len(b.locales))
-	for loc := range b.locales {
+	locales := b.snapshot()
+	result := make([]string, 0, len(locales))
+	for loc := range locales {
 		result = append(result, loc)
 	}
+	if b.lazy != nil {
+		provided, _ := b.lazy.loader.Locales()
+		for _, loc := range provided {
+			if normalized, err := normalizeLocale(loc); err == nil && !slices.Contains(result, normalized) {
+				result = append(result, normalized)
+			}
+		}
+	}
 	return result
 }
+
+// Match returns the loaded locale that best serves the desired locales,
+// listed in order of preference, using CLDR language matching distances.
+// The default locale wins ties and is returned with confidence No when
+// no loaded locale is an acceptable match. Invalid desired tags are ignored.
+func (b *Bundle) Match(desired ...string) (string, locale.Confidence) {
+	var supported []locale.Tag
+	def, err := locale.Parse(b.defaultLocale, locale.WithCanonicalization())
+	if err == nil {
+		supported = append(supported, def)
+	}
+	others := b.AvailableLocales()
+	slices.Sort(others)
+	for _, loc := range others {
+		// Loaded locales are already normalized by AddLocale.
+		if t := locale.MustParse(loc); t != def {
+			supported = append(supported, t)
+		}
+	}
+	if len(supported) == 0 {
+		return b.defaultLocale, locale.No
+	}
+
+	tags := make([]locale.Tag, 0, len(desired))
+	for _, d := range desired {
+		if t, err := locale.Parse(d); err == nil {
+			tags = append(tags, t)
+		}
+	}
+
+	tag, _, conf := locale.NewMatcher(supported).Match(tags...)
+	return tag.String(), conf
+}
+
+// Negotiate returns the loaded locale that best serves an HTTP
+// Accept-Language header, falling back to the default locale. The result
+// can be passed directly to Localizer.
+func (b *Bundle) Negotiate(acceptLanguage string) string {
+	available := b.AvailableLocales()
+	slices.Sort(available)
+	return locale.Negotiate(acceptLanguage, available, b.defaultLocale)
+}
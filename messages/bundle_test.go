@@ -1,7 +1,11 @@
 package messages
 
 import (
+	"fmt"
+	"sync"
 	"testing"
+
+	"github.com/grokify/structured-locale/locale"
 )
 
 func TestBundle_AddLocale(t *testing.T) {
@@ -127,3 +131,135 @@ func TestDefaultBundle(t *testing.T) {
 		t.Errorf("Expected Japanese title, got %v", m)
 	}
 }
+
+func TestBundle_CanonicalLocales(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("iw", []byte(`{"messages": [{"id": "hello", "translation": "שלום"}]}`))
+	_ = b.AddLocaleOverrides("he", []byte(`{"messages": [{"id": "goodbye", "translation": "להתראות"}]}`))
+
+	if got := b.AvailableLocales(); len(got) != 1 || got[0] != "he" {
+		t.Errorf("AvailableLocales() = %v, expected [he]", got)
+	}
+	for _, loc := range []string{"he", "iw", "he-IL"} {
+		if m := b.GetMessage(loc, "hello"); m == nil || m.GetSingular() != "שלום" {
+			t.Errorf("GetMessage(%q, 'hello') = %v", loc, m)
+		}
+	}
+}
+
+func TestBundle_ParentLocaleFallback(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "color", "translation": "color"}]}`))
+	_ = b.AddLocale("en-001", []byte(`{"messages": [{"id": "color", "translation": "colour"}]}`))
+	_ = b.AddLocale("zh", []byte(`{"messages": [{"id": "language", "translation": "语言"}]}`))
+	_ = b.AddLocale("zh-Hant", []byte(`{"messages": [{"id": "language", "translation": "語言"}]}`))
+
+	if m := b.GetMessage("en-AU", "color"); m == nil || m.GetSingular() != "colour" {
+		t.Errorf("Expected 'colour' for en-AU/color, got %v", m)
+	}
+	if m := b.GetMessage("en-US", "color"); m == nil || m.GetSingular() != "color" {
+		t.Errorf("Expected 'color' for en-US/color, got %v", m)
+	}
+	if m := b.GetMessage("zh-TW", "language"); m == nil || m.GetSingular() != "語言" {
+		t.Errorf("Expected '語言' for zh-TW/language, got %v", m)
+	}
+}
+
+func TestBundle_Match(t *testing.T) {
+	b := DefaultBundle()
+
+	tests := []struct {
+		desired  []string
+		expected string
+		conf     locale.Confidence
+	}{
+		{[]string{"fr-CA"}, "fr", locale.High},
+		{[]string{"de"}, "de", locale.Exact},
+		{[]string{"ko", "ja"}, "ja", locale.Exact},
+		{[]string{"zh-TW"}, "zh", locale.Low},
+		{[]string{"ko"}, "en", locale.No},
+		{[]string{"not a tag"}, "en", locale.No},
+	}
+
+	for _, tt := range tests {
+		got, conf := b.Match(tt.desired...)
+		if got != tt.expected || conf != tt.conf {
+			t.Errorf("Match(%v) = %q, %v, expected %q, %v", tt.desired, got, conf, tt.expected, tt.conf)
+		}
+	}
+}
+
+func TestBundle_Negotiate(t *testing.T) {
+	b := DefaultBundle()
+
+	if got := b.Negotiate("fr-CA,fr;q=0.9,en;q=0.8"); got != "fr" {
+		t.Errorf("Negotiate() = %q, expected 'fr'", got)
+	}
+	if got := b.Negotiate("ko-KR"); got != "en" {
+		t.Errorf("Negotiate() = %q, expected 'en'", got)
+	}
+	if got := b.Localizer(b.Negotiate("de-AT")).T("changelog.title"); got == "Changelog" {
+		t.Errorf("expected German translation for de-AT, got %q", got)
+	}
+}
+
+func TestBundle_Concurrent(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "hello", "translation": "Hello"}]}`))
+	_ = b.AddLocale("fr", []byte(`{"messages": [{"id": "hello", "translation": "Bonjour"}]}`))
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := range 50 {
+				data := fmt.Sprintf(`{"messages": [{"id": "hello", "translation": "Salut %d"}, {"id": "k%d", "translation": "v"}]}`, j, i)
+				if err := b.AddLocaleOverrides("fr", []byte(data)); err != nil {
+					t.Errorf("AddLocaleOverrides failed: %v", err)
+				}
+				if j%10 == 0 {
+					_ = b.AddLocale("de", []byte(`{"messages": [{"id": "hello", "translation": "Hallo"}]}`))
+				}
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			l := b.Localizer("fr-CA")
+			for range 50 {
+				if got := l.T("hello"); got == "" || got == "hello" {
+					t.Errorf("T('hello') = %q during concurrent updates", got)
+				}
+				_ = l.Tf("hello", map[string]any{"Name": "x"})
+				_ = b.AvailableLocales()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := range 8 {
+		if m := b.GetMessage("fr", fmt.Sprintf("k%d", i)); m == nil {
+			t.Errorf("override k%d was lost", i)
+		}
+	}
+}
+
+func TestBundle_SnapshotIsolation(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "hello", "translation": "Hello"}]}`))
+
+	before := b.snapshot()["en"]
+	_ = b.AddLocaleOverrides("en", []byte(`{"messages": [{"id": "hello", "translation": "Hi"}]}`))
+
+	if got := before.Get("hello").GetSingular(); got != "Hello" {
+		t.Errorf("previous snapshot was modified: got %q", got)
+	}
+	if got := b.GetMessage("en", "hello").GetSingular(); got != "Hi" {
+		t.Errorf("GetMessage after override = %q, expected 'Hi'", got)
+	}
+
+	var zero Bundle
+	if m := zero.GetMessage("en", "hello"); m != nil {
+		t.Errorf("zero Bundle GetMessage = %v, expected nil", m)
+	}
+}
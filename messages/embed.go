@@ -2,7 +2,6 @@ package messages
 
 import (
 	"embed"
-	"strings"
 )
 
 //go:embed locales/*.json
@@ -13,24 +12,8 @@ var defaultLocales embed.FS
 func DefaultBundle() *Bundle {
 	b := NewBundle("en")
 
-	entries, err := defaultLocales.ReadDir("locales")
-	if err != nil {
-		return b
-	}
-
-	for _, e := range entries {
-		if e.IsDir() || !strings.HasSuffix(e.Name(), ".json") {
-			continue
-		}
-
-		data, err := defaultLocales.ReadFile("locales/" + e.Name())
-		if err != nil {
-			continue
-		}
-
-		loc := strings.TrimSuffix(e.Name(), ".json")
-		_ = b.AddLocale(loc, data)
-	}
+	// The embedded files are validated by tests, so errors are not expected.
+	_ = b.LoadFS(defaultLocales, "locales/*.json")
 
 	return b
 }
@@ -0,0 +1,92 @@
+package messages
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrMessageNotFound is matched by a MessageNotFoundError.
+	ErrMessageNotFound = errors.New("message not found")
+
+	// ErrMissingVariable is matched by a MissingVariableError.
+	ErrMissingVariable = errors.New("missing template variable")
+
+	// ErrPluralFormMissing is matched by a PluralFormMissingError.
+	ErrPluralFormMissing = errors.New("plural form missing")
+
+	// ErrTemplate is matched by a TemplateError.
+	ErrTemplate = errors.New("template error")
+
+	// ErrUnsupportedData is returned for template data that is not a map
+	// with string keys, a struct or a pointer to one.
+	ErrUnsupportedData = errors.New("unsupported template data")
+)
+
+// MessageNotFoundError reports a message ID that is not defined in any
+// locale of the fallback chain.
+type MessageNotFoundError struct {
+	ID    string
+	Chain []string // locales tried, in order
+}
+
+func (e *MessageNotFoundError) Error() string {
+	return fmt.Sprintf("%v: %q (tried %s)", ErrMessageNotFound, e.ID, strings.Join(e.Chain, ", "))
+}
+
+// Unwrap returns ErrMessageNotFound.
+func (e *MessageNotFoundError) Unwrap() error {
+	return ErrMessageNotFound
+}
+
+// MissingVariableError reports placeholders of a message that had no value
+// in the template data. The placeholders are left in the output.
+type MissingVariableError struct {
+	ID    string
+	Names []string // placeholder names, in order of appearance
+}
+
+func (e *MissingVariableError) Error() string {
+	return fmt.Sprintf("%v: %s in %q", ErrMissingVariable, strings.Join(e.Names, ", "), e.ID)
+}
+
+// Unwrap returns ErrMissingVariable.
+func (e *MissingVariableError) Unwrap() error {
+	return ErrMissingVariable
+}
+
+// PluralFormMissingError reports a plural message without a translation for
+// the plural category selected by the count. The "other" form is used instead.
+type PluralFormMissingError struct {
+	ID       string
+	Locale   string // locale the message was found in
+	Category PluralCategory
+}
+
+func (e *PluralFormMissingError) Error() string {
+	return fmt.Sprintf("%v: %q has no %q form in %s", ErrPluralFormMissing, e.ID, e.Category, e.Locale)
+}
+
+// Unwrap returns ErrPluralFormMissing.
+func (e *PluralFormMissingError) Unwrap() error {
+	return ErrPluralFormMissing
+}
+
+// TemplateError reports a translation that could not be parsed or executed
+// as a text/template in the mode enabled by Bundle.EnableTemplates. The
+// raw translation is used instead.
+type TemplateError struct {
+	ID     string
+	Locale string // locale the message was found in
+	Err    error  // error from text/template
+}
+
+func (e *TemplateError) Error() string {
+	return fmt.Sprintf("%v: %q in %s: %v", ErrTemplate, e.ID, e.Locale, e.Err)
+}
+
+// Unwrap returns ErrTemplate and the text/template error.
+func (e *TemplateError) Unwrap() []error {
+	return []error{ErrTemplate, e.Err}
+}
@@ -0,0 +1,111 @@
+package messages
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// goI18nKeys are the keys that make a JSON object a go-i18n message rather
+// than a namespace of nested messages. Keys are matched case-insensitively.
+var goI18nKeys = []string{
+	"id", "description", "hash", "leftdelim", "rightdelim",
+	"zero", "one", "two", "few", "many", "other",
+}
+
+// parseGoI18n parses messages in go-i18n's native JSON format, sorted by ID.
+func parseGoI18n(v map[string]any) ([]Message, error) {
+	var msgs []Message
+	if err := collectGoI18n(v, "", &msgs); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(msgs, func(a, b Message) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return msgs, nil
+}
+
+// collectGoI18n appends the messages of a go-i18n object, joining nested
+// namespaces to prefix with dots.
+func collectGoI18n(v map[string]any, prefix string, msgs *[]Message) error {
+	for key, value := range v {
+		id := key
+		if prefix != "" {
+			id = prefix + "." + key
+		}
+
+		switch val := value.(type) {
+		case string:
+			*msgs = append(*msgs, Message{ID: id, Translation: val})
+		case map[string]any:
+			if !isGoI18nMessage(val) {
+				if err := collectGoI18n(val, id, msgs); err != nil {
+					return err
+				}
+				continue
+			}
+			m, err := goI18nMessage(id, val)
+			if err != nil {
+				return err
+			}
+			*msgs = append(*msgs, m)
+		default:
+			return fmt.Errorf("%s: expected a string or object, got %T", id, value)
+		}
+	}
+	return nil
+}
+
+// isGoI18nMessage reports whether an object is a message: it has a string
+// value for at least one go-i18n message key.
+func isGoI18nMessage(v map[string]any) bool {
+	for key, value := range v {
+		if _, ok := value.(string); ok && slices.Contains(goI18nKeys, strings.ToLower(key)) {
+			return true
+		}
+	}
+	return false
+}
+
+// goI18nMessage converts a go-i18n message object. An "id" key overrides
+// the ID given by the object's position. A message with only an "other"
+// form is not plural.
+func goI18nMessage(id string, v map[string]any) (Message, error) {
+	m := Message{ID: id}
+	forms := map[string]any{}
+	for key, value := range v {
+		k := strings.ToLower(key)
+		if !slices.Contains(goI18nKeys, k) {
+			continue // go-i18n ignores unknown keys
+		}
+		s, ok := value.(string)
+		if !ok {
+			return Message{}, fmt.Errorf("%s: %s: expected a string, got %T", id, key, value)
+		}
+		switch k {
+		case "id":
+			m.ID = s
+		case "description":
+			m.Description = s
+		case "hash":
+			m.Hash = s
+		case "leftdelim":
+			m.LeftDelim = s
+		case "rightdelim":
+			m.RightDelim = s
+		case "zero", "one", "two", "few", "many", "other":
+			forms[k] = s
+		}
+	}
+
+	switch {
+	case len(forms) == 1 && forms["other"] != nil:
+		m.Translation = forms["other"]
+	case len(forms) > 0:
+		m.Translation = forms
+	default:
+		m.Translation = ""
+	}
+	return m, nil
+}
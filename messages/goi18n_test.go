@@ -0,0 +1,122 @@
+package messages
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestParseMessagesJSON_GoI18n(t *testing.T) {
+	data := []byte(`{
+		"hello": "Hello",
+		"person_cats": {
+			"description": "How many cats a person has",
+			"hash": "sha1-abc",
+			"one": "{{.Name}} has {{.Count}} cat.",
+			"other": "{{.Name}} has {{.Count}} cats."
+		},
+		"nav": {
+			"home": "Home",
+			"settings": {
+				"title": {"description": "Settings page title", "other": "Settings"}
+			}
+		},
+		"custom": {"id": "renamed", "other": "Renamed"},
+		"delims": {"leftDelim": "<<", "rightDelim": ">>", "other": "Hi <<.Name>>, {{not a var}}"}
+	}`)
+
+	mf, err := ParseMessagesJSON(data)
+	if err != nil {
+		t.Fatalf("ParseMessagesJSON error: %v", err)
+	}
+
+	var ids []string
+	byID := map[string]Message{}
+	for _, m := range mf.Messages {
+		ids = append(ids, m.ID)
+		byID[m.ID] = m
+	}
+	wantIDs := []string{"delims", "hello", "nav.home", "nav.settings.title", "person_cats", "renamed"}
+	if !slices.Equal(ids, wantIDs) {
+		t.Fatalf("IDs = %v, want %v", ids, wantIDs)
+	}
+
+	cats := byID["person_cats"]
+	if cats.Description != "How many cats a person has" || cats.Hash != "sha1-abc" {
+		t.Errorf("person_cats metadata = %q, %q", cats.Description, cats.Hash)
+	}
+	if !cats.IsPlural() || cats.GetPlural().One != "{{.Name}} has {{.Count}} cat." {
+		t.Errorf("person_cats plural = %+v", cats.GetPlural())
+	}
+
+	title := byID["nav.settings.title"]
+	if title.IsPlural() || title.GetSingular() != "Settings" {
+		t.Errorf("nav.settings.title = %+v, want singular %q", title.Translation, "Settings")
+	}
+}
+
+func TestParseMessagesJSON_GoI18nV1(t *testing.T) {
+	mf, err := ParseMessagesJSON([]byte(`[
+		{"id": "hello", "translation": "Hello"},
+		{"id": "cats", "translation": {"one": "1 cat", "other": "{{.Count}} cats"}}
+	]`))
+	if err != nil {
+		t.Fatalf("ParseMessagesJSON error: %v", err)
+	}
+	if len(mf.Messages) != 2 || mf.Messages[0].GetSingular() != "Hello" || !mf.Messages[1].IsPlural() {
+		t.Errorf("Messages = %+v", mf.Messages)
+	}
+}
+
+func TestParseMessagesJSON_MessagesKey(t *testing.T) {
+	for _, data := range []string{`{"messages": null}`, `{"messages": []}`} {
+		mf, err := ParseMessagesJSON([]byte(data))
+		if err != nil || len(mf.Messages) != 0 {
+			t.Errorf("ParseMessagesJSON(%s) = %+v, %v; want no messages", data, mf, err)
+		}
+	}
+
+	// A "messages" string is a go-i18n message with that ID
+	mf, err := ParseMessagesJSON([]byte(`{"messages": "Messages"}`))
+	if err != nil || len(mf.Messages) != 1 || mf.Messages[0].ID != "messages" {
+		t.Errorf("ParseMessagesJSON(go-i18n messages) = %+v, %v", mf, err)
+	}
+}
+
+func TestParseMessagesJSON_GoI18nErrors(t *testing.T) {
+	for _, data := range []string{
+		`{"hello": 5}`,
+		`{"cats": {"one": "1 cat", "other": 2}}`,
+		`"hello"`,
+	} {
+		if _, err := ParseMessagesJSON([]byte(data)); err == nil {
+			t.Errorf("ParseMessagesJSON(%s) succeeded, want error", data)
+		}
+	}
+}
+
+func TestBundle_GoI18nFile(t *testing.T) {
+	b := NewBundle("en")
+	err := b.AddLocale("en", []byte(`{
+		"greeting": "Hello, {{.Name}}!",
+		"cats": {"one": "{{.Count}} cat", "other": "{{.Count}} cats"},
+		"menu": {"file": {"open": "Open"}},
+		"delims": {"leftDelim": "[[", "rightDelim": "]]", "other": "Hi [[.Name]] {{.Name}}"}
+	}`))
+	if err != nil {
+		t.Fatalf("AddLocale error: %v", err)
+	}
+
+	l := b.Localizer("en")
+	if got := l.Tf("greeting", map[string]any{"Name": "Ana"}); got != "Hello, Ana!" {
+		t.Errorf("Tf(greeting) = %q", got)
+	}
+	if got := l.Tn("cats", 1); got != "1 cat" {
+		t.Errorf("Tn(cats, 1) = %q", got)
+	}
+	if got := l.T("menu.file.open"); got != "Open" {
+		t.Errorf("T(menu.file.open) = %q", got)
+	}
+	if got := l.Tf("delims", map[string]any{"Name": "Ana"}); got != "Hi Ana {{.Name}}" {
+		t.Errorf("Tf(delims) = %q", got)
+	}
+}
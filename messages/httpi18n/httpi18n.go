@@ -0,0 +1,228 @@
+// Package httpi18n provides net/http middleware that resolves the request
+// locale and attaches a messages.Localizer to the request context.
+package httpi18n
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grokify/structured-locale/locale"
+	"github.com/grokify/structured-locale/messages"
+)
+
+// Source extracts candidate locales from a request.
+type Source interface {
+	// Locales returns candidate locales in order of preference.
+	Locales(r *http.Request) []string
+
+	// Vary returns the request header the source depends on, for the
+	// response Vary header, or an empty string.
+	Vary() string
+}
+
+// source is a Source backed by a function.
+type source struct {
+	locales func(r *http.Request) []string
+	vary    string
+}
+
+func (s source) Locales(r *http.Request) []string { return s.locales(r) }
+func (s source) Vary() string                     { return s.vary }
+
+// Query returns a Source reading the locale from a URL query parameter,
+// e.g. "?lang=fr-CA".
+func Query(param string) Source {
+	return source{locales: func(r *http.Request) []string {
+		return nonEmpty(r.URL.Query().Get(param))
+	}}
+}
+
+// Cookie returns a Source reading the locale from a cookie.
+func Cookie(name string) Source {
+	return source{
+		locales: func(r *http.Request) []string {
+			c, err := r.Cookie(name)
+			if err != nil {
+				return nil
+			}
+			return nonEmpty(c.Value)
+		},
+		vary: "Cookie",
+	}
+}
+
+// Header returns a Source reading the locale from a request header such
+// as "X-Locale".
+func Header(name string) Source {
+	return source{
+		locales: func(r *http.Request) []string {
+			return nonEmpty(r.Header.Get(name))
+		},
+		vary: http.CanonicalHeaderKey(name),
+	}
+}
+
+// PathPrefix returns a Source reading the locale from the first path
+// segment, e.g. "/fr-CA/docs". Segments that are not valid language tags
+// are ignored. The path itself is not modified.
+func PathPrefix() Source {
+	return source{locales: func(r *http.Request) []string {
+		segment, _, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+		if _, err := locale.Parse(segment); err != nil {
+			return nil
+		}
+		return []string{segment}
+	}}
+}
+
+// AcceptLanguage returns a Source reading locales from the Accept-Language
+// header in quality order. Resolve and Middleware negotiate the header with
+// Bundle.Negotiate, so locales excluded with q=0 are never served.
+func AcceptLanguage() Source {
+	return acceptLanguage{source{
+		locales: func(r *http.Request) []string {
+			tags, _ := locale.ParseAcceptLanguage(r.Header.Get("Accept-Language"))
+			result := make([]string, 0, len(tags))
+			for _, w := range tags {
+				if !w.IsWildcard() {
+					result = append(result, w.Tag.String())
+				}
+			}
+			return result
+		},
+		vary: "Accept-Language",
+	}}
+}
+
+// negotiator is implemented by sources that choose among the bundle's
+// locales themselves instead of by Bundle.Match.
+type negotiator interface {
+	// negotiate returns the locale for the request, or false if none of
+	// the source's candidates is served by the bundle.
+	negotiate(r *http.Request, b *messages.Bundle) (string, bool)
+}
+
+// acceptLanguage is the Accept-Language Source, which negotiates with the
+// header's q=0 exclusions.
+type acceptLanguage struct {
+	source
+}
+
+// negotiate defers to later sources only if no candidate is served and
+// Bundle.Negotiate falls back to the default locale, which happens when
+// the header is absent or no locale matches and the default is not
+// excluded.
+func (s acceptLanguage) negotiate(r *http.Request, b *messages.Bundle) (string, bool) {
+	header := r.Header.Get("Accept-Language")
+	if strings.TrimSpace(header) == "" {
+		return "", false
+	}
+	loc := b.Negotiate(header)
+	if loc != b.DefaultLocale() {
+		return loc, true
+	}
+	if _, conf := b.Match(s.Locales(r)...); conf != locale.No {
+		return loc, true
+	}
+	return "", false
+}
+
+// nonEmpty returns s as a single-element slice, or nil if s is empty.
+func nonEmpty(s string) []string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+// Middleware returns middleware that resolves the request locale from the
+// sources in order, using the first source whose candidates match a locale
+// loaded in the bundle. If no source matches, the bundle's default locale
+// is used. When no sources are given, AcceptLanguage is used.
+//
+// The resolved *messages.Localizer is stored in the request context, and
+// the response gets a Content-Language header and a Vary header listing
+// the request headers the sources depend on.
+func Middleware(b *messages.Bundle, sources ...Source) func(http.Handler) http.Handler {
+	if len(sources) == 0 {
+		sources = []Source{AcceptLanguage()}
+	}
+
+	var vary []string
+	for _, s := range sources {
+		if v := s.Vary(); v != "" && !containsFold(vary, v) {
+			vary = append(vary, v)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			loc := Resolve(r, b, sources...)
+
+			h := w.Header()
+			h.Set("Content-Language", loc)
+			var existing []string
+			for _, v := range h.Values("Vary") {
+				existing = append(existing, strings.Split(v, ",")...)
+			}
+			for _, v := range vary {
+				if !containsFold(existing, v) {
+					h.Add("Vary", v)
+				}
+			}
+
+			ctx := WithLocalizer(r.Context(), b.Localizer(loc))
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Resolve returns the bundle locale for a request using the sources in
+// order, falling back to the bundle's default locale.
+func Resolve(r *http.Request, b *messages.Bundle, sources ...Source) string {
+	for _, s := range sources {
+		if n, ok := s.(negotiator); ok {
+			if loc, ok := n.negotiate(r, b); ok {
+				return loc
+			}
+			continue
+		}
+		candidates := s.Locales(r)
+		if len(candidates) == 0 {
+			continue
+		}
+		if loc, conf := b.Match(candidates...); conf != locale.No {
+			return loc
+		}
+	}
+	return b.DefaultLocale()
+}
+
+// containsFold reports whether values contains s, ignoring case.
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(strings.TrimSpace(v), s) {
+			return true
+		}
+	}
+	return false
+}
+
+// contextKey is the context key for the request Localizer.
+type contextKey struct{}
+
+// WithLocalizer returns a copy of ctx carrying the Localizer.
+func WithLocalizer(ctx context.Context, l *messages.Localizer) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the Localizer stored by Middleware. If the context
+// has none, it returns a Localizer for the fallback bundle's default locale.
+func FromContext(ctx context.Context, fallback *messages.Bundle) *messages.Localizer {
+	if l, ok := ctx.Value(contextKey{}).(*messages.Localizer); ok && l != nil {
+		return l
+	}
+	return fallback.Localizer(fallback.DefaultLocale())
+}
@@ -0,0 +1,131 @@
+package httpi18n
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/grokify/structured-locale/messages"
+)
+
+func TestMiddleware(t *testing.T) {
+	b := messages.DefaultBundle()
+	sources := []Source{Query("lang"), Cookie("locale"), PathPrefix(), Header("X-Locale"), AcceptLanguage()}
+
+	tests := []struct {
+		name     string
+		target   string
+		setup    func(r *http.Request)
+		expected string
+	}{
+		{"default", "/", nil, "en"},
+		{"query", "/?lang=de", nil, "de"},
+		{"query beats accept-language", "/?lang=ja", func(r *http.Request) {
+			r.Header.Set("Accept-Language", "fr")
+		}, "ja"},
+		{"cookie", "/", func(r *http.Request) {
+			r.AddCookie(&http.Cookie{Name: "locale", Value: "es"})
+		}, "es"},
+		{"path prefix", "/fr-CA/docs", nil, "fr"},
+		{"header", "/docs", func(r *http.Request) {
+			r.Header.Set("X-Locale", "zh")
+		}, "zh"},
+		{"accept-language", "/", func(r *http.Request) {
+			r.Header.Set("Accept-Language", "ko, de-AT;q=0.8")
+		}, "de"},
+		{"accept-language exclusion", "/", func(r *http.Request) {
+			r.Header.Set("Accept-Language", "fr-CA, fr;q=0")
+		}, "en"},
+		{"accept-language exclusion by prefix", "/", func(r *http.Request) {
+			r.Header.Set("Accept-Language", "de-AT, de;q=0, es;q=0.5")
+		}, "es"},
+		{"accept-language wildcard exclusion", "/", func(r *http.Request) {
+			r.Header.Set("Accept-Language", "fr, *;q=0")
+		}, "fr"},
+		{"unmatched source is skipped", "/?lang=ko", func(r *http.Request) {
+			r.Header.Set("Accept-Language", "fr")
+		}, "fr"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			h := Middleware(b, sources...)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = FromContext(r.Context(), b).Locale()
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			if tt.setup != nil {
+				tt.setup(r)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if got != tt.expected {
+				t.Errorf("Localizer locale = %q, expected %q", got, tt.expected)
+			}
+			if cl := w.Header().Get("Content-Language"); cl != tt.expected {
+				t.Errorf("Content-Language = %q, expected %q", cl, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMiddleware_Vary(t *testing.T) {
+	b := messages.DefaultBundle()
+	h := Middleware(b, Cookie("locale"), Header("x-locale"), AcceptLanguage())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+
+	w := httptest.NewRecorder()
+	w.Header().Set("Vary", "Accept-Encoding, Cookie")
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	expected := []string{"Accept-Encoding, Cookie", "X-Locale", "Accept-Language"}
+	if got := w.Header().Values("Vary"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Vary = %v, expected %v", got, expected)
+	}
+}
+
+func TestMiddleware_DefaultSource(t *testing.T) {
+	b := messages.DefaultBundle()
+	var got string
+	h := Middleware(b)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = FromContext(r.Context(), b).T("changelog.title")
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	r.Header.Set("Accept-Language", "fr-FR,fr;q=0.9")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	if got != "Journal des modifications" {
+		t.Errorf("T('changelog.title') = %q, expected French", got)
+	}
+	if v := w.Header().Get("Vary"); v != "Accept-Language" {
+		t.Errorf("Vary = %q, expected 'Accept-Language'", v)
+	}
+}
+
+func TestResolve_MatchesNegotiate(t *testing.T) {
+	b := messages.DefaultBundle()
+	for _, header := range []string{"fr-CA, fr;q=0", "fr-CA", "*, en;q=0", "ko, de;q=0.5, de-AT;q=0"} {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set("Accept-Language", header)
+		if got, want := Resolve(r, b, AcceptLanguage()), b.Negotiate(header); got != want {
+			t.Errorf("Resolve(%q) = %q, Negotiate = %q", header, got, want)
+		}
+	}
+}
+
+func TestFromContext_Fallback(t *testing.T) {
+	b := messages.NewBundle("de")
+	if got := FromContext(context.Background(), b).Locale(); got != "de" {
+		t.Errorf("FromContext() locale = %q, expected 'de'", got)
+	}
+
+	l := b.Localizer("fr")
+	if got := FromContext(WithLocalizer(context.Background(), l), b); got != l {
+		t.Errorf("FromContext() = %v, expected stored Localizer", got)
+	}
+}
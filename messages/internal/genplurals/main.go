@@ -0,0 +1,500 @@
+// Command genplurals compiles the CLDR supplemental plural rules and plural
+// ranges into Go lookup tables for the messages package, together with
+// tests built from the @integer and @decimal samples of every rule.
+//
+// It is run by go generate from the messages package directory:
+//
+//	go run ./internal/genplurals
+//
+// The CLDR data is read from internal/genplurals/cldr, which holds copies
+// of common/supplemental/plurals.xml, ordinals.xml and pluralRanges.xml.
+package main
+
+import (
+	"bytes"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/grokify/structured-locale/messages/internal/pluralrule"
+)
+
+// supplementalData mirrors the parts of the CLDR supplemental data files
+// that hold plural rules.
+type supplementalData struct {
+	Plurals []struct {
+		Type        string `xml:"type,attr"`
+		PluralRules []struct {
+			Locales    string `xml:"locales,attr"`
+			PluralRule []struct {
+				Count string `xml:"count,attr"`
+				Rule  string `xml:",chardata"`
+			} `xml:"pluralRule"`
+		} `xml:"pluralRules"`
+		PluralRanges []struct {
+			Locales     string `xml:"locales,attr"`
+			PluralRange []struct {
+				Start  string `xml:"start,attr"`
+				End    string `xml:"end,attr"`
+				Result string `xml:"result,attr"`
+			} `xml:"pluralRange"`
+		} `xml:"pluralRanges"`
+	} `xml:"plurals"`
+}
+
+// ruleSet is the rules shared by a group of locales.
+type ruleSet struct {
+	name    string // Go function name
+	locales []string
+	rules   []categoryRule
+}
+
+// rangeSet is the plural ranges shared by a group of locales.
+type rangeSet struct {
+	name    string // Go variable name
+	locales []string
+	ranges  [][3]string // start, end and result categories
+}
+
+// categoryRule is the rule and samples of one plural category.
+type categoryRule struct {
+	category string
+	rule     pluralrule.Rule
+	samples  []string
+}
+
+func main() {
+	dataDir := flag.String("data", filepath.Join("internal", "genplurals", "cldr"), "directory containing plurals.xml, ordinals.xml and pluralRanges.xml")
+	out := flag.String("out", "plural_tables.go", "output file for the rule tables")
+	testOut := flag.String("test", "plural_tables_test.go", "output file for the sample tests")
+	flag.Parse()
+
+	cardinal, err := readRuleSets(filepath.Join(*dataDir, "plurals.xml"), "cardinal")
+	if err != nil {
+		log.Fatal(err)
+	}
+	ordinal, err := readRuleSets(filepath.Join(*dataDir, "ordinals.xml"), "ordinal")
+	if err != nil {
+		log.Fatal(err)
+	}
+	ranges, err := readRangeSets(filepath.Join(*dataDir, "pluralRanges.xml"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := writeSource(*out, generateTables(cardinal, ordinal, ranges)); err != nil {
+		log.Fatal(err)
+	}
+	if err := writeSource(*testOut, generateTests(cardinal, ordinal, ranges)); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readSupplemental reads a CLDR supplemental data file.
+func readSupplemental(path string) (*supplementalData, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var doc supplementalData
+	if err := xml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &doc, nil
+}
+
+// readRuleSets reads and parses the rule sets of one plural type.
+func readRuleSets(path, pluralType string) ([]ruleSet, error) {
+	doc, err := readSupplemental(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []ruleSet
+	for _, plurals := range doc.Plurals {
+		if plurals.Type != pluralType {
+			continue
+		}
+		for _, group := range plurals.PluralRules {
+			set := ruleSet{locales: splitLocales(group.Locales)}
+			if len(set.locales) == 0 {
+				continue
+			}
+			set.name = pluralType + funcSuffix(set.locales[0])
+
+			for _, pr := range group.PluralRule {
+				rule, samples, err := pluralrule.Parse(pr.Rule)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s %s: %w", path, group.Locales, pr.Count, err)
+				}
+				values, err := pluralrule.ExpandSamples(append(samples.Integer, samples.Decimal...))
+				if err != nil {
+					return nil, fmt.Errorf("%s: %s %s: %w", path, group.Locales, pr.Count, err)
+				}
+				set.rules = append(set.rules, categoryRule{category: pr.Count, rule: rule, samples: values})
+			}
+			sets = append(sets, set)
+		}
+	}
+	if len(sets) == 0 {
+		return nil, fmt.Errorf("%s: no %s plural rules", path, pluralType)
+	}
+	return sets, nil
+}
+
+// readRangeSets reads the plural range sets.
+func readRangeSets(path string) ([]rangeSet, error) {
+	doc, err := readSupplemental(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []rangeSet
+	for _, plurals := range doc.Plurals {
+		for _, group := range plurals.PluralRanges {
+			set := rangeSet{locales: splitLocales(group.Locales)}
+			if len(set.locales) == 0 {
+				continue
+			}
+			set.name = "ranges" + funcSuffix(set.locales[0])
+			for _, pr := range group.PluralRange {
+				for _, c := range []string{pr.Start, pr.End, pr.Result} {
+					if _, ok := categoryConst[c]; !ok {
+						return nil, fmt.Errorf("%s: %s: unknown plural category %q", path, group.Locales, c)
+					}
+				}
+				set.ranges = append(set.ranges, [3]string{pr.Start, pr.End, pr.Result})
+			}
+			sets = append(sets, set)
+		}
+	}
+	if len(sets) == 0 {
+		return nil, fmt.Errorf("%s: no plural ranges", path)
+	}
+	return sets, nil
+}
+
+// splitLocales splits a CLDR locale list, dropping root and converting
+// locales such as "pt_PT" to "pt-PT".
+func splitLocales(list string) []string {
+	var locales []string
+	for _, loc := range strings.Fields(list) {
+		if loc != "root" {
+			locales = append(locales, strings.ReplaceAll(loc, "_", "-"))
+		}
+	}
+	return locales
+}
+
+// funcSuffix turns a locale such as "pt-PT" into "PtPT".
+func funcSuffix(loc string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(loc, "-") {
+		b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+	}
+	return b.String()
+}
+
+// categoryConst maps CLDR category names to PluralCategory constants.
+var categoryConst = map[string]string{
+	"zero":  "PluralZero",
+	"one":   "PluralOne",
+	"two":   "PluralTwo",
+	"few":   "PluralFew",
+	"many":  "PluralMany",
+	"other": "PluralOther",
+}
+
+const header = "// Code generated by genplurals from CLDR plurals.xml, ordinals.xml and pluralRanges.xml; DO NOT EDIT.\n\n"
+
+// generateTables returns the source of the rule functions and tables.
+func generateTables(cardinal, ordinal []ruleSet, ranges []rangeSet) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package messages\n\n")
+
+	writeTable(&b, "// cardinalRules maps languages, and locales with regional rules, to their\n// CLDR cardinal plural rules.\n",
+		"var cardinalRules = map[string]pluralRuleFunc", ruleNames(cardinal))
+	writeTable(&b, "// ordinalRules maps languages, and locales with regional rules, to their\n// CLDR ordinal plural rules.\n",
+		"var ordinalRules = map[string]pluralRuleFunc", ruleNames(ordinal))
+	writeTable(&b, "// pluralRangeRules maps languages to the CLDR plural categories of ranges.\n",
+		"var pluralRangeRules = map[string]map[pluralRange]PluralCategory", rangeNames(ranges))
+
+	for _, set := range append(slices.Clone(cardinal), ordinal...) {
+		writeFunc(&b, set)
+	}
+	for _, set := range ranges {
+		writeRanges(&b, set)
+	}
+	return b.Bytes()
+}
+
+// ruleNames maps each locale to the name of its rule function.
+func ruleNames(sets []ruleSet) map[string]string {
+	names := map[string]string{}
+	for _, set := range sets {
+		for _, loc := range set.locales {
+			names[loc] = set.name
+		}
+	}
+	return names
+}
+
+// rangeNames maps each locale to the name of its range table.
+func rangeNames(sets []rangeSet) map[string]string {
+	names := map[string]string{}
+	for _, set := range sets {
+		for _, loc := range set.locales {
+			names[loc] = set.name
+		}
+	}
+	return names
+}
+
+// writeTable writes a map literal from locale to name, sorted by locale.
+func writeTable(b *bytes.Buffer, doc, decl string, names map[string]string) {
+	locales := make([]string, 0, len(names))
+	for loc := range names {
+		locales = append(locales, loc)
+	}
+	slices.Sort(locales)
+
+	b.WriteString(doc)
+	b.WriteString(decl + "{\n")
+	for _, loc := range locales {
+		fmt.Fprintf(b, "%q: %s,\n", loc, names[loc])
+	}
+	b.WriteString("}\n\n")
+}
+
+// writeRanges writes the range table of a range set.
+func writeRanges(b *bytes.Buffer, set rangeSet) {
+	fmt.Fprintf(b, "// %s holds the plural ranges for %s.\n", set.name, strings.Join(set.locales, ", "))
+	fmt.Fprintf(b, "var %s = map[pluralRange]PluralCategory{\n", set.name)
+	for _, r := range set.ranges {
+		fmt.Fprintf(b, "{%s, %s}: %s,\n", categoryConst[r[0]], categoryConst[r[1]], categoryConst[r[2]])
+	}
+	b.WriteString("}\n\n")
+}
+
+// writeFunc writes the rule function of a rule set.
+func writeFunc(b *bytes.Buffer, set ruleSet) {
+	fmt.Fprintf(b, "// %s implements the rules for %s.\n", set.name, strings.Join(set.locales, ", "))
+	for _, cr := range set.rules {
+		if len(cr.rule.Or) > 0 {
+			fmt.Fprintf(b, "// %s: %s\n", cr.category, cr.rule)
+		}
+	}
+	fmt.Fprintf(b, "func %s(op PluralOperands) PluralCategory {\n", set.name)
+
+	var cases []categoryRule
+	for _, cr := range set.rules {
+		if len(cr.rule.Or) > 0 {
+			cases = append(cases, cr)
+		}
+	}
+	if len(cases) == 0 {
+		b.WriteString("return PluralOther\n}\n\n")
+		return
+	}
+	b.WriteString("switch {\n")
+	for _, cr := range cases {
+		fmt.Fprintf(b, "case %s:\nreturn %s\n", ruleExpr(cr.rule), categoryConst[cr.category])
+	}
+	b.WriteString("}\nreturn PluralOther\n}\n\n")
+}
+
+// ruleExpr returns a Go expression over op that is true when the rule
+// matches. Relations on n only hold for integral values, where n equals
+// the integer digits i, so a conjunction with any "n =" relation checks
+// for a zero fraction once.
+func ruleExpr(r pluralrule.Rule) string {
+	ors := make([]string, len(r.Or))
+	for i, and := range r.Or {
+		integral := slices.ContainsFunc(and, func(rel pluralrule.Relation) bool {
+			return rel.Operand == 'n' && !rel.Negate
+		})
+		var rels []string
+		if integral {
+			rels = append(rels, "op.T == 0")
+		}
+		for _, rel := range and {
+			rels = append(rels, relationExpr(rel, integral))
+		}
+		ors[i] = strings.Join(rels, " && ")
+	}
+	return strings.Join(ors, " ||\n")
+}
+
+// relationExpr returns a Go expression for a relation. When integral is
+// false, "n !=" relations also hold for numbers with a fraction.
+func relationExpr(rel pluralrule.Relation, integral bool) string {
+	x := operandExpr(rel.Operand)
+	if rel.Mod != 0 {
+		x = fmt.Sprintf("%s%%%d", x, rel.Mod)
+	}
+	if !rel.Negate {
+		return inExpr(x, rel.Ranges)
+	}
+	if rel.Operand == 'n' && !integral {
+		return "(op.T != 0 || " + notInExpr(x, rel.Ranges) + ")"
+	}
+	return notInExpr(x, rel.Ranges)
+}
+
+// operandExpr returns the PluralOperands field for an operand. The
+// integral part of n is read from i.
+func operandExpr(operand byte) string {
+	switch operand {
+	case 'n', 'i':
+		return "op.I"
+	case 'c', 'e':
+		return "op.E"
+	}
+	return "op." + strings.ToUpper(string(operand))
+}
+
+// inExpr returns an expression that is true when x is in one of ranges.
+func inExpr(x string, ranges []pluralrule.Range) string {
+	terms := make([]string, len(ranges))
+	for i, r := range ranges {
+		switch {
+		case r.Lo == r.Hi:
+			terms[i] = fmt.Sprintf("%s == %d", x, r.Lo)
+		case r.Lo == 0:
+			terms[i] = fmt.Sprintf("%s <= %d", x, r.Hi)
+		default:
+			terms[i] = fmt.Sprintf("%s >= %d && %s <= %d", x, r.Lo, x, r.Hi)
+		}
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return "(" + strings.Join(terms, " || ") + ")"
+}
+
+// notInExpr returns an expression that is true when x is in none of
+// ranges.
+func notInExpr(x string, ranges []pluralrule.Range) string {
+	terms := make([]string, len(ranges))
+	for i, r := range ranges {
+		switch {
+		case r.Lo == r.Hi:
+			terms[i] = fmt.Sprintf("%s != %d", x, r.Lo)
+		case r.Lo == 0:
+			terms[i] = fmt.Sprintf("%s > %d", x, r.Hi)
+		default:
+			terms[i] = fmt.Sprintf("(%s < %d || %s > %d)", x, r.Lo, x, r.Hi)
+		}
+	}
+	if len(terms) == 1 {
+		return terms[0]
+	}
+	return "(" + strings.Join(terms, " && ") + ")"
+}
+
+// generateTests returns the source of tests that check every sample value
+// against the category it is listed under.
+func generateTests(cardinal, ordinal []ruleSet, ranges []rangeSet) []byte {
+	var b bytes.Buffer
+	b.WriteString(header)
+	b.WriteString("package messages\n\n")
+	b.WriteString("import (\n\"strconv\"\n\"testing\"\n)\n\n")
+
+	b.WriteString("// pluralSamples lists CLDR sample values by locale group and category.\n")
+	b.WriteString("type pluralSamples struct {\nlocales []string\ncategory PluralCategory\nsamples []string\n}\n\n")
+	writeSamples(&b, "cardinalSamples", cardinal)
+	writeSamples(&b, "ordinalSamples", ordinal)
+
+	b.WriteString("var pluralRangeSamples = []struct {\nlocales []string\nstart, end, result PluralCategory\n}{\n")
+	for _, set := range ranges {
+		for _, r := range set.ranges {
+			fmt.Fprintf(&b, "{%s, %s, %s, %s},\n", stringSlice(set.locales),
+				categoryConst[r[0]], categoryConst[r[1]], categoryConst[r[2]])
+		}
+	}
+	b.WriteString("}\n\n")
+
+	b.WriteString(`func TestCardinalRuleSamples(t *testing.T) {
+	for _, tt := range cardinalSamples {
+		for _, loc := range tt.locales {
+			for _, s := range tt.samples {
+				op, err := ParsePluralOperands(s)
+				if err != nil {
+					t.Fatalf("ParsePluralOperands(%q) error: %v", s, err)
+				}
+				if got := GetPluralCategoryOperands(loc, op); got != tt.category {
+					t.Errorf("GetPluralCategoryOperands(%q, %s) = %q, want %q", loc, s, got, tt.category)
+				}
+			}
+		}
+	}
+}
+
+func TestOrdinalRuleSamples(t *testing.T) {
+	for _, tt := range ordinalSamples {
+		for _, loc := range tt.locales {
+			for _, s := range tt.samples {
+				n, err := strconv.Atoi(s)
+				if err != nil {
+					t.Fatalf("strconv.Atoi(%q) error: %v", s, err)
+				}
+				if got := GetOrdinalCategory(loc, n); got != tt.category {
+					t.Errorf("GetOrdinalCategory(%q, %d) = %q, want %q", loc, n, got, tt.category)
+				}
+			}
+		}
+	}
+}
+
+func TestPluralRangeRules(t *testing.T) {
+	for _, tt := range pluralRangeSamples {
+		for _, loc := range tt.locales {
+			if got := GetPluralRangeCategory(loc, tt.start, tt.end); got != tt.result {
+				t.Errorf("GetPluralRangeCategory(%q, %q, %q) = %q, want %q", loc, tt.start, tt.end, got, tt.result)
+			}
+		}
+	}
+}
+`)
+	return b.Bytes()
+}
+
+// writeSamples writes the sample table of one plural type.
+func writeSamples(b *bytes.Buffer, name string, sets []ruleSet) {
+	fmt.Fprintf(b, "var %s = []pluralSamples{\n", name)
+	for _, set := range sets {
+		for _, cr := range set.rules {
+			if len(cr.samples) == 0 {
+				continue
+			}
+			fmt.Fprintf(b, "{\nlocales: %s,\ncategory: %s,\nsamples: %s,\n},\n",
+				stringSlice(set.locales), categoryConst[cr.category], stringSlice(cr.samples))
+		}
+	}
+	b.WriteString("}\n\n")
+}
+
+// stringSlice returns a []string literal.
+func stringSlice(values []string) string {
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+// writeSource formats and writes generated Go source.
+func writeSource(path string, src []byte) error {
+	formatted, err := format.Source(src)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
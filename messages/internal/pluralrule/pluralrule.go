@@ -0,0 +1,297 @@
+// Package pluralrule parses and evaluates CLDR plural rules, as defined in
+// UTS #35, Part 3, Section 5.1: Plural rules syntax.
+//
+// A rule such as "v = 0 and i % 10 = 1 and i % 100 != 11 @integer 1, 21"
+// consists of a condition over the plural operands of a number, followed
+// by optional sample values.
+package pluralrule
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrSyntax is returned for rules that do not follow the CLDR syntax.
+var ErrSyntax = errors.New("invalid plural rule")
+
+// Operands are the plural operands of a number. N is the absolute value;
+// the others are integers as defined by UTS #35.
+type Operands struct {
+	N                float64
+	I, V, W, F, T, E int64
+}
+
+// Rule is a parsed plural rule condition: relations joined by "and",
+// grouped by "or". The empty rule, used for "other", always matches.
+type Rule struct {
+	Or [][]Relation
+}
+
+// Relation compares an operand, optionally taken modulo Mod, against a
+// list of values and ranges.
+type Relation struct {
+	Operand byte // one of n, i, v, w, f, t, c, e
+	Mod     int64
+	Negate  bool // "!=" rather than "="
+	Ranges  []Range
+}
+
+// Range is an inclusive range of integers; single values have Lo == Hi.
+type Range struct {
+	Lo, Hi int64
+}
+
+// Samples are the example values listed after a rule.
+type Samples struct {
+	Integer []string
+	Decimal []string
+}
+
+// Parse parses a plural rule with optional "@integer" and "@decimal"
+// samples. Sample lists are returned unexpanded; see ExpandSamples.
+func Parse(s string) (Rule, Samples, error) {
+	cond, samples, err := splitSamples(s)
+	if err != nil {
+		return Rule{}, Samples{}, err
+	}
+
+	var rule Rule
+	cond = strings.TrimSpace(cond)
+	if cond == "" {
+		return rule, samples, nil
+	}
+	for _, or := range strings.Split(cond, " or ") {
+		var and []Relation
+		for _, rel := range strings.Split(or, " and ") {
+			r, err := parseRelation(strings.TrimSpace(rel))
+			if err != nil {
+				return Rule{}, Samples{}, err
+			}
+			and = append(and, r)
+		}
+		rule.Or = append(rule.Or, and)
+	}
+	return rule, samples, nil
+}
+
+// splitSamples separates the condition from the sample lists.
+func splitSamples(s string) (string, Samples, error) {
+	var samples Samples
+	cond, rest, _ := strings.Cut(s, "@")
+	if rest == "" {
+		return cond, samples, nil
+	}
+	for _, part := range strings.Split("@"+rest, "@")[1:] {
+		kind, list, _ := strings.Cut(part, " ")
+		switch kind {
+		case "integer":
+			samples.Integer = splitList(list)
+		case "decimal":
+			samples.Decimal = splitList(list)
+		default:
+			return "", Samples{}, fmt.Errorf("%w: unknown sample type %q", ErrSyntax, kind)
+		}
+	}
+	return cond, samples, nil
+}
+
+// splitList splits a comma-separated sample list, dropping ellipses.
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" && item != "…" && item != "..." {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseRelation parses "operand [% mod] (= | !=) range_list".
+func parseRelation(s string) (Relation, error) {
+	var r Relation
+	op := "="
+	lhs, rhs, ok := strings.Cut(s, "!=")
+	if ok {
+		r.Negate = true
+		op = "!="
+	} else if lhs, rhs, ok = strings.Cut(s, "="); !ok {
+		return Relation{}, fmt.Errorf("%w: missing '=' in %q", ErrSyntax, s)
+	}
+
+	operand, mod, hasMod := strings.Cut(strings.TrimSpace(lhs), "%")
+	operand = strings.TrimSpace(operand)
+	if len(operand) != 1 || !strings.Contains("nivwftce", operand) {
+		return Relation{}, fmt.Errorf("%w: unknown operand %q", ErrSyntax, operand)
+	}
+	r.Operand = operand[0]
+	if hasMod {
+		m, err := strconv.ParseInt(strings.TrimSpace(mod), 10, 64)
+		if err != nil || m <= 0 {
+			return Relation{}, fmt.Errorf("%w: invalid modulus in %q", ErrSyntax, s)
+		}
+		r.Mod = m
+	}
+
+	for _, item := range strings.Split(rhs, ",") {
+		lo, hi, isRange := strings.Cut(strings.TrimSpace(item), "..")
+		l, err := strconv.ParseInt(lo, 10, 64)
+		if err != nil {
+			return Relation{}, fmt.Errorf("%w: invalid value %q after %s", ErrSyntax, item, op)
+		}
+		h := l
+		if isRange {
+			if h, err = strconv.ParseInt(hi, 10, 64); err != nil || h < l {
+				return Relation{}, fmt.Errorf("%w: invalid range %q", ErrSyntax, item)
+			}
+		}
+		r.Ranges = append(r.Ranges, Range{Lo: l, Hi: h})
+	}
+	return r, nil
+}
+
+// Match reports whether the rule matches the operands.
+func (r Rule) Match(op Operands) bool {
+	if len(r.Or) == 0 {
+		return true
+	}
+	for _, and := range r.Or {
+		matched := true
+		for _, rel := range and {
+			if !rel.Match(op) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Match reports whether the relation holds for the operands. Values of n
+// with a fraction match no range.
+func (r Relation) Match(op Operands) bool {
+	x := r.value(op)
+	in := false
+	if x == math.Trunc(x) {
+		for _, rng := range r.Ranges {
+			if x >= float64(rng.Lo) && x <= float64(rng.Hi) {
+				in = true
+				break
+			}
+		}
+	}
+	return in != r.Negate
+}
+
+// value returns the operand value, taken modulo Mod if set.
+func (r Relation) value(op Operands) float64 {
+	if r.Operand == 'n' {
+		if r.Mod != 0 {
+			return math.Mod(op.N, float64(r.Mod))
+		}
+		return op.N
+	}
+
+	var v int64
+	switch r.Operand {
+	case 'i':
+		v = op.I
+	case 'v':
+		v = op.V
+	case 'w':
+		v = op.W
+	case 'f':
+		v = op.F
+	case 't':
+		v = op.T
+	case 'c', 'e':
+		v = op.E
+	}
+	if r.Mod != 0 {
+		v %= r.Mod
+	}
+	return float64(v)
+}
+
+// String returns the rule in CLDR syntax, without samples.
+func (r Rule) String() string {
+	ors := make([]string, len(r.Or))
+	for i, and := range r.Or {
+		rels := make([]string, len(and))
+		for j, rel := range and {
+			rels[j] = rel.String()
+		}
+		ors[i] = strings.Join(rels, " and ")
+	}
+	return strings.Join(ors, " or ")
+}
+
+// String returns the relation in CLDR syntax.
+func (r Relation) String() string {
+	var b strings.Builder
+	b.WriteByte(r.Operand)
+	if r.Mod != 0 {
+		fmt.Fprintf(&b, " %% %d", r.Mod)
+	}
+	if r.Negate {
+		b.WriteString(" != ")
+	} else {
+		b.WriteString(" = ")
+	}
+	for i, rng := range r.Ranges {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if rng.Lo == rng.Hi {
+			fmt.Fprintf(&b, "%d", rng.Lo)
+		} else {
+			fmt.Fprintf(&b, "%d..%d", rng.Lo, rng.Hi)
+		}
+	}
+	return b.String()
+}
+
+// maxExpansion limits how many values a single sample range expands to.
+const maxExpansion = 100
+
+// ExpandSamples expands sample ranges such as "2~16" or "0.0~1.5" into
+// individual values, stepping by the last digit of the range bounds.
+// Values in compact exponent notation, such as "1c6", are kept as they are.
+func ExpandSamples(samples []string) ([]string, error) {
+	var values []string
+	for _, s := range samples {
+		lo, hi, isRange := strings.Cut(s, "~")
+		if !isRange {
+			values = append(values, s)
+			continue
+		}
+
+		_, loFrac, _ := strings.Cut(lo, ".")
+		_, hiFrac, _ := strings.Cut(hi, ".")
+		if len(loFrac) != len(hiFrac) {
+			return nil, fmt.Errorf("%w: sample range %q has mismatched precision", ErrSyntax, s)
+		}
+		digits := len(loFrac)
+		scale := math.Pow10(digits)
+
+		from, err1 := strconv.ParseFloat(lo, 64)
+		to, err2 := strconv.ParseFloat(hi, 64)
+		if err1 != nil || err2 != nil || to < from {
+			return nil, fmt.Errorf("%w: invalid sample range %q", ErrSyntax, s)
+		}
+		start, end := int64(math.Round(from*scale)), int64(math.Round(to*scale))
+		if end-start >= maxExpansion {
+			return nil, fmt.Errorf("%w: sample range %q is too large", ErrSyntax, s)
+		}
+		for v := start; v <= end; v++ {
+			values = append(values, strconv.FormatFloat(float64(v)/scale, 'f', digits, 64))
+		}
+	}
+	return values, nil
+}
@@ -0,0 +1,106 @@
+package pluralrule
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	rule, samples, err := Parse("v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 @integer 2~4, 22, … @decimal 0.2~0.4, 10.2, …")
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if got, want := rule.String(), "v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if want := []string{"2~4", "22"}; !slices.Equal(samples.Integer, want) {
+		t.Errorf("Integer samples = %v, want %v", samples.Integer, want)
+	}
+	if want := []string{"0.2~0.4", "10.2"}; !slices.Equal(samples.Decimal, want) {
+		t.Errorf("Decimal samples = %v, want %v", samples.Decimal, want)
+	}
+
+	tests := []struct {
+		op   Operands
+		want bool
+	}{
+		{Operands{N: 2, I: 2}, true},
+		{Operands{N: 22, I: 22}, true},
+		{Operands{N: 12, I: 12}, false},
+		{Operands{N: 5, I: 5}, false},
+		{Operands{N: 1.3, I: 1, V: 1, W: 1, F: 3, T: 3}, true},
+		{Operands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5}, false},
+	}
+	for _, tt := range tests {
+		if got := rule.Match(tt.op); got != tt.want {
+			t.Errorf("Match(%+v) = %v, want %v", tt.op, got, tt.want)
+		}
+	}
+}
+
+func TestParse_Other(t *testing.T) {
+	rule, samples, err := Parse(" @integer 0, 2~16, 100, …")
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if len(rule.Or) != 0 || !rule.Match(Operands{N: 7, I: 7}) {
+		t.Errorf("empty rule = %+v, want always matching", rule)
+	}
+	if want := []string{"0", "2~16", "100"}; !slices.Equal(samples.Integer, want) {
+		t.Errorf("Integer samples = %v, want %v", samples.Integer, want)
+	}
+}
+
+func TestRelation_NFraction(t *testing.T) {
+	rule, _, err := Parse("n = 1")
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if !rule.Match(Operands{N: 1, I: 1, V: 1}) {
+		t.Error(`"n = 1" should match 1.0`)
+	}
+	if rule.Match(Operands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5}) {
+		t.Error(`"n = 1" should not match 1.5`)
+	}
+
+	rule, _, err = Parse("n != 1")
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if !rule.Match(Operands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5}) {
+		t.Error(`"n != 1" should match 1.5`)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	for _, s := range []string{
+		"x = 1",
+		"n % 0 = 1",
+		"n 1",
+		"n = 4..2",
+		"n = a",
+		"n = 1 @fraction 1.5",
+	} {
+		if _, _, err := Parse(s); !errors.Is(err, ErrSyntax) {
+			t.Errorf("Parse(%q) error = %v, want ErrSyntax", s, err)
+		}
+	}
+}
+
+func TestExpandSamples(t *testing.T) {
+	got, err := ExpandSamples([]string{"2~4", "0.8~1.1", "1c6", "100"})
+	if err != nil {
+		t.Fatalf("ExpandSamples error: %v", err)
+	}
+	want := []string{"2", "3", "4", "0.8", "0.9", "1.0", "1.1", "1c6", "100"}
+	if !slices.Equal(got, want) {
+		t.Errorf("ExpandSamples = %v, want %v", got, want)
+	}
+
+	for _, s := range []string{"1.0~2", "5~3", "0~1000"} {
+		if _, err := ExpandSamples([]string{s}); !errors.Is(err, ErrSyntax) {
+			t.Errorf("ExpandSamples(%q) error = %v, want ErrSyntax", s, err)
+		}
+	}
+}
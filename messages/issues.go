@@ -0,0 +1,208 @@
+package messages
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// IssueKind classifies a translation problem reported to an IssueHook.
+type IssueKind string
+
+const (
+	// IssueMissingMessage means no locale in the fallback chain defines the message.
+	IssueMissingMessage IssueKind = "missing_message"
+
+	// IssueFallback means the message was served from a fallback locale.
+	IssueFallback IssueKind = "fallback"
+
+	// IssueUnresolvedVariable means {{.Name}} placeholders had no value and
+	// were left in the output.
+	IssueUnresolvedVariable IssueKind = "unresolved_variable"
+
+	// IssueMissingPluralForm means a plural message had no form for the
+	// count's plural category and the "other" form was used.
+	IssueMissingPluralForm IssueKind = "missing_plural_form"
+
+	// IssueTemplateError means a translation failed to parse or execute as
+	// a template and the raw translation was used.
+	IssueTemplateError IssueKind = "template_error"
+
+	// IssueLoadError means the Loader of a lazily loading bundle failed to
+	// load a locale, for example because a file could not be parsed. The
+	// issue has no message ID.
+	IssueLoadError IssueKind = "load_error"
+)
+
+// Issue describes a translation problem encountered by a Localizer.
+type Issue struct {
+	Kind       IssueKind
+	Locale     string   // locale requested from the Localizer
+	ID         string   // message ID
+	ServedFrom string   // locale that served the message, if any
+	Variables  []string // unresolved placeholder names, for IssueUnresolvedVariable
+	Err        error    // loader error, for IssueLoadError
+}
+
+// IssueHook is called for every translation problem. It is called
+// synchronously from the translating goroutine and must be safe for
+// concurrent use.
+type IssueHook func(Issue)
+
+// SetIssueHook sets the hook called when a Localizer of this bundle looks
+// up a missing message, serves a fallback translation or leaves
+// placeholders unresolved. Pass nil to remove the hook.
+func (b *Bundle) SetIssueHook(fn IssueHook) {
+	if fn == nil {
+		b.hook.Store(nil)
+		return
+	}
+	b.hook.Store(&fn)
+}
+
+// WithIssueHook returns a copy of the Localizer that reports issues to fn
+// instead of the bundle's hook.
+func (l *Localizer) WithIssueHook(fn IssueHook) *Localizer {
+	c := *l
+	c.hook = fn
+	return &c
+}
+
+// report passes the issues found by a lookup to the active hook.
+func (l *Localizer) report(id string, r Result, err error) {
+	hook := l.hook
+	if hook == nil {
+		if p := l.bundle.hook.Load(); p != nil {
+			hook = *p
+		}
+	}
+	if hook == nil {
+		return
+	}
+
+	issue := Issue{Locale: l.locale, ID: id, ServedFrom: r.Locale}
+	if errors.Is(err, ErrMessageNotFound) {
+		issue.Kind = IssueMissingMessage
+		hook(issue)
+		return
+	}
+	if r.Fallback {
+		issue.Kind = IssueFallback
+		hook(issue)
+	}
+	if errors.Is(err, ErrPluralFormMissing) {
+		issue.Kind = IssueMissingPluralForm
+		hook(issue)
+	}
+	var missing *MissingVariableError
+	if errors.As(err, &missing) {
+		issue.Kind = IssueUnresolvedVariable
+		issue.Variables = missing.Names
+		hook(issue)
+	}
+	if errors.Is(err, ErrTemplate) {
+		issue.Kind = IssueTemplateError
+		issue.Variables = nil
+		hook(issue)
+	}
+}
+
+// reportLoadError passes a lazy load failure to the bundle's hook.
+func (b *Bundle) reportLoadError(loc string, err error) {
+	if p := b.hook.Load(); p != nil {
+		(*p)(Issue{Kind: IssueLoadError, Locale: loc, Err: err})
+	}
+}
+
+// IssueCount is an aggregated count of one kind of issue for a message in
+// a requested locale.
+type IssueCount struct {
+	Kind       IssueKind `json:"kind"`
+	Locale     string    `json:"locale"`
+	ID         string    `json:"id"`
+	ServedFrom string    `json:"servedFrom,omitempty"`
+	Variables  []string  `json:"variables,omitempty"`
+	Count      int64     `json:"count"`
+}
+
+// IssueCollector aggregates issues in memory by kind, locale and message
+// ID. Use its Record method as an IssueHook:
+//
+//	c := messages.NewIssueCollector()
+//	bundle.SetIssueHook(c.Record)
+type IssueCollector struct {
+	mu     sync.Mutex
+	counts map[issueKey]*IssueCount
+}
+
+// issueKey identifies an aggregated issue.
+type issueKey struct {
+	kind   IssueKind
+	locale string
+	id     string
+}
+
+// NewIssueCollector creates an empty IssueCollector.
+func NewIssueCollector() *IssueCollector {
+	return &IssueCollector{counts: make(map[issueKey]*IssueCount)}
+}
+
+// Record counts an issue. ServedFrom and Variables keep their most
+// recently reported values.
+func (c *IssueCollector) Record(i Issue) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := issueKey{kind: i.Kind, locale: i.Locale, id: i.ID}
+	ic, ok := c.counts[key]
+	if !ok {
+		ic = &IssueCount{Kind: i.Kind, Locale: i.Locale, ID: i.ID}
+		c.counts[key] = ic
+	}
+	ic.ServedFrom = i.ServedFrom
+	ic.Variables = slices.Clone(i.Variables)
+	ic.Count++
+}
+
+// Counts returns the aggregated issues sorted by kind, locale and ID.
+func (c *IssueCollector) Counts() []IssueCount {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counts := make([]IssueCount, 0, len(c.counts))
+	for _, ic := range c.counts {
+		counts = append(counts, *ic)
+	}
+	slices.SortFunc(counts, func(a, b IssueCount) int {
+		return cmp.Or(
+			strings.Compare(string(a.Kind), string(b.Kind)),
+			strings.Compare(a.Locale, b.Locale),
+			strings.Compare(a.ID, b.ID),
+		)
+	})
+	return counts
+}
+
+// Reset discards all recorded issues.
+func (c *IssueCollector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.counts)
+}
+
+// MarshalJSON encodes the aggregated issues as a JSON array in the order
+// returned by Counts.
+func (c *IssueCollector) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Counts())
+}
+
+// WriteJSON writes the aggregated issues to w as indented JSON.
+func (c *IssueCollector) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(c.Counts())
+}
@@ -0,0 +1,116 @@
+package messages
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func newIssuesTestBundle() *Bundle {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "hello", "translation": "Hello, {{.Name}}"},
+		{"id": "only_en", "translation": "English only"}
+	]}`))
+	_ = b.AddLocale("fr", []byte(`{"messages": [{"id": "hello", "translation": "Bonjour, {{.Name}}"}]}`))
+	return b
+}
+
+func TestBundle_SetIssueHook(t *testing.T) {
+	b := newIssuesTestBundle()
+	var issues []Issue
+	b.SetIssueHook(func(i Issue) { issues = append(issues, i) })
+
+	l := b.Localizer("fr")
+	_ = l.Tf("hello", map[string]any{"Name": "Ana"})
+	if len(issues) != 0 {
+		t.Fatalf("expected no issues, got %+v", issues)
+	}
+
+	_ = l.T("nonexistent")
+	_ = l.T("only_en")
+	_ = l.T("hello")
+
+	expected := []Issue{
+		{Kind: IssueMissingMessage, Locale: "fr", ID: "nonexistent"},
+		{Kind: IssueFallback, Locale: "fr", ID: "only_en", ServedFrom: "en"},
+		{Kind: IssueUnresolvedVariable, Locale: "fr", ID: "hello", ServedFrom: "fr", Variables: []string{"Name"}},
+	}
+	if len(issues) != len(expected) {
+		t.Fatalf("got %d issues %+v, expected %d", len(issues), issues, len(expected))
+	}
+	for i, e := range expected {
+		got := issues[i]
+		if got.Kind != e.Kind || got.Locale != e.Locale || got.ID != e.ID ||
+			got.ServedFrom != e.ServedFrom || !slices.Equal(got.Variables, e.Variables) {
+			t.Errorf("issue %d = %+v, expected %+v", i, got, e)
+		}
+	}
+
+	// Removing the hook stops reporting
+	b.SetIssueHook(nil)
+	_ = l.T("nonexistent")
+	if len(issues) != len(expected) {
+		t.Errorf("expected no issues after removing the hook, got %d", len(issues)-len(expected))
+	}
+}
+
+func TestLocalizer_WithIssueHook(t *testing.T) {
+	b := newIssuesTestBundle()
+	var bundleIssues, localizerIssues int
+	b.SetIssueHook(func(Issue) { bundleIssues++ })
+
+	l := b.Localizer("fr").WithIssueHook(func(Issue) { localizerIssues++ })
+	_ = l.T("nonexistent")
+	_ = b.Localizer("fr").T("nonexistent")
+
+	if localizerIssues != 1 || bundleIssues != 1 {
+		t.Errorf("localizer hook called %d times, bundle hook %d times; expected 1 each", localizerIssues, bundleIssues)
+	}
+}
+
+func TestIssueCollector(t *testing.T) {
+	b := newIssuesTestBundle()
+	c := NewIssueCollector()
+	b.SetIssueHook(c.Record)
+
+	for range 3 {
+		_ = b.Localizer("fr").T("nonexistent")
+	}
+	_ = b.Localizer("de").T("nonexistent")
+	_ = b.Localizer("fr").T("only_en")
+
+	expected := []IssueCount{
+		{Kind: IssueFallback, Locale: "fr", ID: "only_en", ServedFrom: "en", Count: 1},
+		{Kind: IssueMissingMessage, Locale: "de", ID: "nonexistent", Count: 1},
+		{Kind: IssueMissingMessage, Locale: "fr", ID: "nonexistent", Count: 3},
+	}
+	got := c.Counts()
+	if len(got) != len(expected) {
+		t.Fatalf("Counts() = %+v, expected %+v", got, expected)
+	}
+	for i := range expected {
+		if got[i].Kind != expected[i].Kind || got[i].Locale != expected[i].Locale ||
+			got[i].ID != expected[i].ID || got[i].ServedFrom != expected[i].ServedFrom || got[i].Count != expected[i].Count {
+			t.Errorf("Counts()[%d] = %+v, expected %+v", i, got[i], expected[i])
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := c.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	var decoded []IssueCount
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("WriteJSON output is not valid JSON: %v", err)
+	}
+	if len(decoded) != len(expected) || decoded[2].Count != 3 {
+		t.Errorf("decoded JSON = %+v", decoded)
+	}
+
+	c.Reset()
+	if n := len(c.Counts()); n != 0 {
+		t.Errorf("expected no counts after Reset, got %d", n)
+	}
+}
@@ -0,0 +1,341 @@
+package messages
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrLocaleNotFound is returned by a Loader that has no messages for a locale.
+var ErrLocaleNotFound = errors.New("locale not found")
+
+// lazyRetryInterval is how long a lazily loading bundle waits before
+// loading a locale again after its Loader failed.
+const lazyRetryInterval = time.Minute
+
+// Loader provides messages for locales on demand.
+type Loader interface {
+	// Locales returns the locales the loader can provide.
+	Locales() ([]string, error)
+
+	// Load returns the messages for a normalized locale tag, or an error
+	// wrapping ErrLocaleNotFound if the loader has none.
+	Load(loc string) ([]Message, error)
+}
+
+// LazyOption configures a lazily loading Bundle.
+type LazyOption func(*lazyLoader)
+
+// WithMaxLocales enables eviction of the least recently used lazily loaded
+// locales once more than n are loaded. Evicted locales are loaded again on
+// next use. The default locale and locales changed with AddLocale or
+// AddLocaleOverrides are never evicted. Zero disables eviction.
+func WithMaxLocales(n int) LazyOption {
+	return func(l *lazyLoader) {
+		l.maxLocales = n
+	}
+}
+
+// NewLazyBundle creates a bundle that parses a locale's messages only when
+// a Localizer or GetMessage first needs them, including locales reached
+// through the fallback chain. Concurrent first uses of a locale share a
+// single load.
+//
+// A locale the loader does not have is not requested again. Other load
+// failures, such as a file that cannot be parsed, are reported once to the
+// issue hook as IssueLoadError and retried after a minute. AddLocale and
+// AddLocaleOverrides clear a failure.
+func NewLazyBundle(defaultLocale string, loader Loader, opts ...LazyOption) *Bundle {
+	b := NewBundle(defaultLocale)
+	b.lazy = &lazyLoader{
+		loader:   loader,
+		inflight: make(map[string]*lazyCall),
+		now:      time.Now,
+		pinned:   make(map[string]bool),
+	}
+	for _, opt := range opts {
+		opt(b.lazy)
+	}
+	if normalized, err := normalizeLocale(defaultLocale); err == nil {
+		b.lazy.pinned[normalized] = true
+	}
+	return b
+}
+
+// lazyLoader holds the on-demand loading state of a Bundle.
+type lazyLoader struct {
+	loader     Loader
+	maxLocales int
+
+	clock    atomic.Uint64
+	lastUsed sync.Map // normalized locale -> *atomic.Uint64
+	failed   sync.Map // normalized locale -> lazyFailure, read without mu
+
+	mu       sync.Mutex
+	inflight map[string]*lazyCall
+	pinned   map[string]bool // locales never evicted
+	loaded   []string        // lazily loaded locales
+	now      func() time.Time
+}
+
+// lazyFailure is a remembered load failure.
+type lazyFailure struct {
+	err   error
+	retry time.Time // when to load again; zero for never
+}
+
+// failure returns the remembered error of a locale that failed to load and
+// is not due for a retry, or nil.
+func (l *lazyLoader) failure(loc string) error {
+	v, ok := l.failed.Load(loc)
+	if !ok {
+		return nil
+	}
+	f := v.(lazyFailure)
+	if !f.retry.IsZero() && !l.now().Before(f.retry) {
+		return nil
+	}
+	return f.err
+}
+
+// lazyCall is an in-progress load shared by concurrent callers.
+type lazyCall struct {
+	done chan struct{}
+	ms   *MessageSet
+	err  error
+}
+
+// touch records a use of a loaded locale for eviction.
+func (l *lazyLoader) touch(loc string) {
+	if l.maxLocales <= 0 {
+		return
+	}
+	now := l.clock.Add(1)
+	if v, ok := l.lastUsed.Load(loc); ok {
+		v.(*atomic.Uint64).Store(now)
+		return
+	}
+	var v atomic.Uint64
+	v.Store(now)
+	l.lastUsed.Store(loc, &v)
+}
+
+// pin marks a locale as changed outside the loader so it is not evicted
+// or lazily loaded again.
+func (l *lazyLoader) pin(loc string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pinned[loc] = true
+	l.failed.Delete(loc)
+}
+
+// messageSet returns the MessageSet for a normalized locale, loading it if
+// necessary. Returns nil if the locale is not available.
+func (b *Bundle) messageSet(loc string) *MessageSet {
+	if ms, ok := b.snapshot()[loc]; ok {
+		if b.lazy != nil {
+			b.lazy.touch(loc)
+		}
+		return ms
+	}
+	if b.lazy == nil {
+		return nil
+	}
+	// Locales that failed to load, such as regional variants the loader
+	// lacks, are skipped without taking the lock
+	if b.lazy.failure(loc) != nil {
+		return nil
+	}
+	ms, _ := b.loadLazy(loc)
+	return ms
+}
+
+// loadLazy loads a locale through the bundle's Loader, sharing the load
+// between concurrent callers and remembering failed loads.
+func (b *Bundle) loadLazy(loc string) (*MessageSet, error) {
+	l := b.lazy
+
+	l.mu.Lock()
+	if ms, ok := b.snapshot()[loc]; ok {
+		l.mu.Unlock()
+		return ms, nil
+	}
+	if err := l.failure(loc); err != nil {
+		l.mu.Unlock()
+		return nil, err
+	}
+	if c, ok := l.inflight[loc]; ok {
+		l.mu.Unlock()
+		<-c.done
+		return c.ms, c.err
+	}
+	c := &lazyCall{done: make(chan struct{})}
+	l.inflight[loc] = c
+	l.mu.Unlock()
+
+	msgs, err := l.loader.Load(loc)
+
+	var report bool
+	l.mu.Lock()
+	switch {
+	case l.pinned[loc] && b.snapshot()[loc] != nil:
+		// AddLocale replaced the locale while it was loading
+		c.ms = b.snapshot()[loc]
+	case err != nil:
+		c.err = err
+		f := lazyFailure{err: err}
+		if !errors.Is(err, ErrLocaleNotFound) {
+			f.retry = l.now().Add(lazyRetryInterval)
+			report = true
+		}
+		l.failed.Store(loc, f)
+	default:
+		l.failed.Delete(loc)
+		b.update(func(locales map[string]*MessageSet) {
+			setMessages(locales, loc, msgs, false)
+			c.ms = locales[loc]
+		})
+		l.loaded = append(l.loaded, loc)
+		l.touch(loc)
+		b.evictLocked(loc)
+	}
+	delete(l.inflight, loc)
+	l.mu.Unlock()
+	close(c.done)
+
+	if report {
+		b.reportLoadError(loc, err)
+	}
+
+	return c.ms, c.err
+}
+
+// evictLocked removes the least recently used lazily loaded locales beyond
+// the configured maximum, never evicting keep. The caller holds lazy.mu.
+func (b *Bundle) evictLocked(keep string) {
+	l := b.lazy
+	if l.maxLocales <= 0 {
+		return
+	}
+
+	var candidates []string
+	for _, loc := range l.loaded {
+		if !l.pinned[loc] && loc != keep {
+			candidates = append(candidates, loc)
+		}
+	}
+	excess := len(l.loaded) - l.maxLocales
+	if excess <= 0 {
+		return
+	}
+
+	lastUsed := func(loc string) uint64 {
+		if v, ok := l.lastUsed.Load(loc); ok {
+			return v.(*atomic.Uint64).Load()
+		}
+		return 0
+	}
+	slices.SortFunc(candidates, func(a, b string) int {
+		return cmp.Compare(lastUsed(a), lastUsed(b))
+	})
+	if excess > len(candidates) {
+		excess = len(candidates)
+	}
+	evict := candidates[:excess]
+
+	b.update(func(locales map[string]*MessageSet) {
+		for _, loc := range evict {
+			delete(locales, loc)
+		}
+	})
+	l.loaded = slices.DeleteFunc(l.loaded, func(loc string) bool {
+		return slices.Contains(evict, loc)
+	})
+	for _, loc := range evict {
+		l.lastUsed.Delete(loc)
+	}
+}
+
+// FSLoader returns a Loader reading messages files in fsys that match the
+// fs.Glob pattern, inferring each file's locale with InferLocale. Files are
+// indexed on first use; a locale's files are only read when it is loaded.
+func FSLoader(fsys fs.FS, pattern string) Loader {
+	return &fsLoader{fsys: fsys, pattern: pattern}
+}
+
+// fsLoader is the Loader returned by FSLoader.
+type fsLoader struct {
+	fsys    fs.FS
+	pattern string
+
+	once  sync.Once
+	files map[string][]string // normalized locale -> file names
+	err   error
+}
+
+// index maps normalized locales to their files.
+func (f *fsLoader) index() (map[string][]string, error) {
+	f.once.Do(func() {
+		names, err := fs.Glob(f.fsys, f.pattern)
+		if err != nil {
+			f.err = fmt.Errorf("indexing messages: %w", err)
+			return
+		}
+		slices.Sort(names)
+
+		f.files = make(map[string][]string)
+		var errs []error
+		for _, name := range names {
+			loc, err := InferLocale(name)
+			if err == nil {
+				loc, err = normalizeLocale(loc)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", name, err))
+				continue
+			}
+			f.files[loc] = append(f.files[loc], name)
+		}
+		f.err = errors.Join(errs...)
+	})
+	return f.files, f.err
+}
+
+// Locales returns the locales found in the file index.
+func (f *fsLoader) Locales() ([]string, error) {
+	files, err := f.index()
+	locales := make([]string, 0, len(files))
+	for loc := range files {
+		locales = append(locales, loc)
+	}
+	slices.Sort(locales)
+	return locales, err
+}
+
+// Load reads and combines all files for a locale.
+func (f *fsLoader) Load(loc string) ([]Message, error) {
+	files, _ := f.index()
+	names, ok := files[loc]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrLocaleNotFound, loc)
+	}
+
+	var msgs []Message
+	for _, name := range names {
+		data, err := fs.ReadFile(f.fsys, name)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		mf, err := ParseMessagesJSON(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		msgs = append(msgs, mf.Messages...)
+	}
+	return msgs, nil
+}
@@ -0,0 +1,271 @@
+package messages
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+// countingLoader wraps a Loader and counts Load calls per locale.
+type countingLoader struct {
+	Loader
+	mu    sync.Mutex
+	loads map[string]int
+}
+
+func newCountingLoader(l Loader) *countingLoader {
+	return &countingLoader{Loader: l, loads: make(map[string]int)}
+}
+
+func (c *countingLoader) Load(loc string) ([]Message, error) {
+	c.mu.Lock()
+	c.loads[loc]++
+	c.mu.Unlock()
+	return c.Loader.Load(loc)
+}
+
+func (c *countingLoader) count(loc string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.loads[loc]
+}
+
+func lazyTestFS() fstest.MapFS {
+	return fstest.MapFS{
+		"locales/en.json":    {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hello"}, {"id": "only_en", "translation": "English only"}]}`)},
+		"locales/fr.json":    {Data: []byte(`{"messages": [{"id": "hello", "translation": "Bonjour"}]}`)},
+		"locales/fr-CA.json": {Data: []byte(`{"messages": [{"id": "bye", "translation": "Bye-bye"}]}`)},
+		"locales/de.json":    {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hallo"}]}`)},
+		"locales/es.json":    {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hola"}]}`)},
+	}
+}
+
+func TestLazyBundle_LoadsOnFirstUse(t *testing.T) {
+	loader := newCountingLoader(FSLoader(lazyTestFS(), "locales/*.json"))
+	b := NewLazyBundle("en", loader)
+
+	if got := len(b.snapshot()); got != 0 {
+		t.Fatalf("expected no locales loaded before use, got %d", got)
+	}
+
+	if got := b.Localizer("de").T("hello"); got != "Hallo" {
+		t.Errorf("Expected 'Hallo', got %q", got)
+	}
+	if loader.count("de") != 1 {
+		t.Errorf("Expected de to be loaded once, got %d", loader.count("de"))
+	}
+	if loader.count("fr") != 0 {
+		t.Errorf("Expected fr not to be loaded, got %d", loader.count("fr"))
+	}
+
+	// Loaded locales are cached
+	_ = b.Localizer("de").T("hello")
+	if loader.count("de") != 1 {
+		t.Errorf("Expected de to be loaded once, got %d", loader.count("de"))
+	}
+}
+
+func TestLazyBundle_FallbackChain(t *testing.T) {
+	loader := newCountingLoader(FSLoader(lazyTestFS(), "locales/*.json"))
+	b := NewLazyBundle("en", loader)
+
+	l := b.Localizer("fr-CA")
+	if got := l.T("bye"); got != "Bye-bye" {
+		t.Errorf("Expected 'Bye-bye', got %q", got)
+	}
+	if got := l.T("hello"); got != "Bonjour" {
+		t.Errorf("Expected 'Bonjour', got %q", got)
+	}
+	if got := l.T("only_en"); got != "English only" {
+		t.Errorf("Expected 'English only', got %q", got)
+	}
+
+	// Missing locales are remembered and not looked up again
+	_ = b.Localizer("it").T("hello")
+	_ = b.Localizer("it").T("hello")
+	if loader.count("it") != 1 {
+		t.Errorf("Expected one lookup of missing locale it, got %d", loader.count("it"))
+	}
+}
+
+func TestLazyBundle_ConcurrentFirstUse(t *testing.T) {
+	var loads atomic.Int32
+	release := make(chan struct{})
+	loader := &funcLoader{
+		load: func(loc string) ([]Message, error) {
+			loads.Add(1)
+			<-release
+			return []Message{{ID: "hello", Translation: "Hallo"}}, nil
+		},
+	}
+	b := NewLazyBundle("de", loader)
+
+	var wg sync.WaitGroup
+	results := make([]string, 20)
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = b.Localizer("de").T("hello")
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := loads.Load(); got != 1 {
+		t.Errorf("Expected a single load, got %d", got)
+	}
+	for i, r := range results {
+		if r != "Hallo" {
+			t.Errorf("result %d = %q, expected 'Hallo'", i, r)
+		}
+	}
+}
+
+func TestLazyBundle_Eviction(t *testing.T) {
+	loader := newCountingLoader(FSLoader(lazyTestFS(), "locales/*.json"))
+	b := NewLazyBundle("en", loader, WithMaxLocales(2))
+
+	_ = b.Localizer("en").T("hello")
+	_ = b.Localizer("de").T("hello")
+	_ = b.Localizer("es").T("hello")
+
+	loaded := b.snapshot()
+	if _, ok := loaded["en"]; !ok {
+		t.Error("default locale en was evicted")
+	}
+	if _, ok := loaded["de"]; ok {
+		t.Error("expected least recently used locale de to be evicted")
+	}
+	if _, ok := loaded["es"]; !ok {
+		t.Error("expected es to stay loaded")
+	}
+
+	// Evicted locales are reloaded on demand
+	if got := b.Localizer("de").T("hello"); got != "Hallo" {
+		t.Errorf("Expected 'Hallo', got %q", got)
+	}
+	if loader.count("de") != 2 {
+		t.Errorf("Expected de to be loaded twice, got %d", loader.count("de"))
+	}
+}
+
+func TestLazyBundle_AddLocaleOverrides(t *testing.T) {
+	b := NewLazyBundle("en", FSLoader(lazyTestFS(), "locales/*.json"), WithMaxLocales(1))
+
+	err := b.AddLocaleOverrides("fr", []byte(`{"messages": [{"id": "bye", "translation": "Salut"}]}`))
+	if err != nil {
+		t.Fatalf("AddLocaleOverrides failed: %v", err)
+	}
+	_ = b.Localizer("de").T("hello")
+	_ = b.Localizer("es").T("hello")
+
+	l := b.Localizer("fr")
+	if got := l.T("hello"); got != "Bonjour" {
+		t.Errorf("Expected 'Bonjour' from loader, got %q", got)
+	}
+	if got := l.T("bye"); got != "Salut" {
+		t.Errorf("Expected override 'Salut', got %q", got)
+	}
+}
+
+func TestLazyBundle_AvailableLocales(t *testing.T) {
+	b := NewLazyBundle("en", FSLoader(lazyTestFS(), "locales/*.json"))
+
+	got := b.AvailableLocales()
+	slices.Sort(got)
+	expected := []string{"de", "en", "es", "fr", "fr-CA"}
+	if !slices.Equal(got, expected) {
+		t.Errorf("AvailableLocales() = %v, expected %v", got, expected)
+	}
+}
+
+func TestFSLoader_NotFound(t *testing.T) {
+	_, err := FSLoader(lazyTestFS(), "locales/*.json").Load("it")
+	if !errors.Is(err, ErrLocaleNotFound) {
+		t.Errorf("Load(it) error = %v, expected ErrLocaleNotFound", err)
+	}
+}
+
+// funcLoader is a Loader backed by a function.
+type funcLoader struct {
+	load func(loc string) ([]Message, error)
+}
+
+func (f *funcLoader) Locales() ([]string, error) { return nil, nil }
+
+func (f *funcLoader) Load(loc string) ([]Message, error) { return f.load(loc) }
+
+func TestLazyBundle_LoadErrorCached(t *testing.T) {
+	var loads atomic.Int32
+	loader := &funcLoader{
+		load: func(loc string) ([]Message, error) {
+			if loc == "en" {
+				return []Message{{ID: "hello", Translation: "Hello"}}, nil
+			}
+			loads.Add(1)
+			return nil, errors.New("fr.json: invalid character")
+		},
+	}
+	b := NewLazyBundle("en", loader)
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	b.lazy.now = func() time.Time { return now }
+
+	var loadErrors []Issue
+	b.SetIssueHook(func(i Issue) {
+		if i.Kind == IssueLoadError {
+			loadErrors = append(loadErrors, i)
+		}
+	})
+
+	for range 3 {
+		if got := b.Localizer("fr").T("hello"); got != "Hello" {
+			t.Errorf("T(hello) = %q, expected English fallback", got)
+		}
+	}
+	if got := loads.Load(); got != 1 {
+		t.Errorf("Expected a single load of the broken locale, got %d", got)
+	}
+	if len(loadErrors) != 1 || loadErrors[0].Locale != "fr" || loadErrors[0].Err == nil {
+		t.Errorf("load error issues = %+v, expected one for fr", loadErrors)
+	}
+
+	// The failure is retried after the retry interval
+	now = now.Add(lazyRetryInterval)
+	b.Localizer("fr").T("hello")
+	if got := loads.Load(); got != 2 {
+		t.Errorf("Expected a retry after the interval, got %d loads", got)
+	}
+
+	// AddLocale replaces the broken locale
+	_ = b.AddLocale("fr", []byte(`{"messages": [{"id": "hello", "translation": "Bonjour"}]}`))
+	if got := b.Localizer("fr").T("hello"); got != "Bonjour" {
+		t.Errorf("T(hello) after AddLocale = %q", got)
+	}
+}
+
+func TestLazyBundle_MissingLocaleLockFree(t *testing.T) {
+	b := NewLazyBundle("en", FSLoader(lazyTestFS(), "locales/*.json"))
+	l := b.Localizer("en-US")
+	if got := l.T("hello"); got != "Hello" {
+		t.Fatalf("T(hello) = %q", got)
+	}
+
+	// Lookups through a locale the loader lacks must not wait for the lock
+	b.lazy.mu.Lock()
+	defer b.lazy.mu.Unlock()
+	done := make(chan string, 1)
+	go func() { done <- l.T("hello") }()
+	select {
+	case got := <-done:
+		if got != "Hello" {
+			t.Errorf("T(hello) = %q", got)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("lookup blocked on the lazy loader lock")
+	}
+}
@@ -0,0 +1,173 @@
+package messages
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/grokify/structured-locale/locale"
+)
+
+// ErrNoLocale is returned when a file's locale cannot be inferred from its path.
+var ErrNoLocale = errors.New("cannot infer locale from path")
+
+// LoadOption configures LoadFS and LoadDir.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	merge    bool
+	localeOf func(name string) (string, error)
+}
+
+// WithMerge merges loaded messages into existing locales, like
+// AddLocaleOverrides, instead of replacing them.
+func WithMerge() LoadOption {
+	return func(o *loadOptions) {
+		o.merge = true
+	}
+}
+
+// WithLocaleFunc overrides how a file's locale is inferred from its path.
+// The default is InferLocale.
+func WithLocaleFunc(fn func(name string) (string, error)) LoadOption {
+	return func(o *loadOptions) {
+		o.localeOf = fn
+	}
+}
+
+// InferLocale infers the locale of a messages file from its slash-separated
+// path. Supported layouts, tried in order, are:
+//
+//   - "messages.fr-CA.json": the last dot-separated part of the file name
+//   - "locales/fr-CA.json": the file name without its extension
+//   - "fr-CA/messages.json": the parent directory name
+//
+// Short names such as "ui" or "app" are well-formed language codes, so a
+// candidate is preferred only if it is a known locale: one with a script
+// or region, or a language with CLDR likely subtags. If no candidate is
+// known, the first well-formed one is used.
+func InferLocale(name string) (string, error) {
+	dir, file := path.Split(name)
+	stem := strings.TrimSuffix(file, path.Ext(file))
+
+	var candidates []string
+	if i := strings.LastIndex(stem, "."); i >= 0 {
+		candidates = append(candidates, stem[i+1:])
+	}
+	candidates = append(candidates, stem, path.Base(dir))
+
+	first := ""
+	for _, c := range candidates {
+		t, err := locale.Parse(c)
+		if err != nil {
+			continue
+		}
+		if isKnownLocale(t) {
+			return c, nil
+		}
+		if first == "" {
+			first = c
+		}
+	}
+	if first != "" {
+		return first, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrNoLocale, name)
+}
+
+// isKnownLocale reports whether t is more than a bare language code or
+// its language has CLDR likely subtags.
+func isKnownLocale(t locale.Tag) bool {
+	if t.Script != "" || t.Region != "" {
+		return true
+	}
+	return t.Maximize().Script != ""
+}
+
+// LoadFS loads all messages files in fsys matching the fs.Glob pattern,
+// such as "locales/*.json", "*/messages.json" or "messages.*.json",
+// inferring each file's locale from its path with InferLocale.
+//
+// Files for the same locale are combined, then each locale is replaced
+// (or merged with WithMerge) in a single atomic update. Files that cannot
+// be read or parsed are skipped; their errors are returned together,
+// each prefixed with the file name.
+func (b *Bundle) LoadFS(fsys fs.FS, pattern string, opts ...LoadOption) error {
+	o := loadOptions{localeOf: InferLocale}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return fmt.Errorf("loading messages: %w", err)
+	}
+	slices.Sort(names)
+
+	var errs []error
+	loaded := make(map[string][]Message)
+	for _, name := range names {
+		msgs, normalized, err := loadFile(fsys, name, o.localeOf)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+			continue
+		}
+		if msgs == nil {
+			continue
+		}
+		loaded[normalized] = append(loaded[normalized], msgs...)
+	}
+
+	if len(loaded) > 0 {
+		b.update(func(locales map[string]*MessageSet) {
+			for normalized, msgs := range loaded {
+				setMessages(locales, normalized, msgs, o.merge)
+			}
+		})
+	}
+	return errors.Join(errs...)
+}
+
+// LoadDir loads messages files from a directory on disk.
+// See LoadFS for the pattern syntax and options.
+func (b *Bundle) LoadDir(dir string, pattern string, opts ...LoadOption) error {
+	return b.LoadFS(os.DirFS(dir), pattern, opts...)
+}
+
+// loadFile reads and parses a single messages file, returning its messages
+// and normalized locale. Directories are skipped with nil messages.
+func loadFile(fsys fs.FS, name string, localeOf func(string) (string, error)) ([]Message, string, error) {
+	info, err := fs.Stat(fsys, name)
+	if err != nil {
+		return nil, "", err
+	}
+	if info.IsDir() {
+		return nil, "", nil
+	}
+
+	loc, err := localeOf(name)
+	if err != nil {
+		return nil, "", err
+	}
+	normalized, err := normalizeLocale(loc)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, "", err
+	}
+	mf, err := ParseMessagesJSON(data)
+	if err != nil {
+		return nil, "", err
+	}
+	if mf.Messages == nil {
+		mf.Messages = []Message{}
+	}
+	return mf.Messages, normalized, nil
+}
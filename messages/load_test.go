@@ -0,0 +1,168 @@
+package messages
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestInferLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+		wantErr  bool
+	}{
+		{"locales/fr-CA.json", "fr-CA", false},
+		{"fr.json", "fr", false},
+		{"fr-CA/messages.json", "fr-CA", false},
+		{"i18n/de/messages.json", "de", false},
+		{"messages.fr-CA.json", "fr-CA", false},
+		{"locales/active.zh-Hant.json", "zh-Hant", false},
+		{"fr-CA/ui.json", "fr-CA", false},
+		{"de/app.json", "de", false},
+		{"locales/de/app.json", "de", false},
+		{"ja/ui.ja.json", "ja", false},
+		{"app/eo.json", "eo", false},
+		{"messages.json", "", true},
+		{"locales/messages.json", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InferLocale(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("InferLocale(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrNoLocale) {
+				t.Errorf("InferLocale(%q) error = %v, expected ErrNoLocale", tt.name, err)
+			}
+			if got != tt.expected {
+				t.Errorf("InferLocale(%q) = %q, expected %q", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBundle_LoadFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/en.json":    {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hello"}]}`)},
+		"locales/fr-CA.json": {Data: []byte(`{"messages": [{"id": "hello", "translation": "Allo"}]}`)},
+		"fr/messages.json":   {Data: []byte(`{"messages": [{"id": "hello", "translation": "Bonjour"}]}`)},
+		"fr/extra.json":      {Data: []byte(`{"messages": [{"id": "bye", "translation": "Au revoir"}]}`)},
+		"messages.de.json":   {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hallo"}]}`)},
+	}
+
+	b := NewBundle("en")
+	for _, pattern := range []string{"locales/*.json", "fr/*.json", "messages.*.json"} {
+		if err := b.LoadFS(fsys, pattern); err != nil {
+			t.Fatalf("LoadFS(%q) failed: %v", pattern, err)
+		}
+	}
+
+	available := b.AvailableLocales()
+	slices.Sort(available)
+	if expected := []string{"de", "en", "fr", "fr-CA"}; !slices.Equal(available, expected) {
+		t.Errorf("AvailableLocales() = %v, expected %v", available, expected)
+	}
+
+	l := b.Localizer("fr-CA")
+	if got := l.T("hello"); got != "Allo" {
+		t.Errorf("fr-CA hello = %q, expected 'Allo'", got)
+	}
+	if got := l.T("bye"); got != "Au revoir" {
+		t.Errorf("fr-CA bye = %q, expected 'Au revoir' from fr/extra.json", got)
+	}
+}
+
+func TestBundle_LoadFS_Errors(t *testing.T) {
+	fsys := fstest.MapFS{
+		"locales/en.json":       {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hello"}]}`)},
+		"locales/fr.json":       {Data: []byte(`{not json`)},
+		"locales/messages.json": {Data: []byte(`{"messages": []}`)},
+	}
+
+	b := NewBundle("en")
+	err := b.LoadFS(fsys, "locales/*.json")
+	if err == nil {
+		t.Fatal("LoadFS expected error")
+	}
+	for _, name := range []string{"locales/fr.json", "locales/messages.json"} {
+		if !strings.Contains(err.Error(), name) {
+			t.Errorf("error %q does not mention %s", err, name)
+		}
+	}
+	if !errors.Is(err, ErrNoLocale) {
+		t.Errorf("error %q does not wrap ErrNoLocale", err)
+	}
+
+	// Valid files are still loaded
+	if got := b.Localizer("en").T("hello"); got != "Hello" {
+		t.Errorf("T('hello') = %q, expected 'Hello'", got)
+	}
+
+	if err := b.LoadFS(fsys, "[bad"); err == nil {
+		t.Error("LoadFS with invalid pattern expected error")
+	}
+}
+
+func TestBundle_LoadFS_Merge(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "hello", "translation": "Hello"}, {"id": "bye", "translation": "Bye"}]}`))
+
+	fsys := fstest.MapFS{
+		"en.json": {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hi"}]}`)},
+	}
+
+	if err := b.LoadFS(fsys, "*.json", WithMerge()); err != nil {
+		t.Fatalf("LoadFS failed: %v", err)
+	}
+	if m := b.GetMessage("en", "bye"); m == nil {
+		t.Error("merge removed existing message 'bye'")
+	}
+
+	if err := b.LoadFS(fsys, "*.json"); err != nil {
+		t.Fatalf("LoadFS failed: %v", err)
+	}
+	if m := b.GetMessage("en", "bye"); m != nil {
+		t.Error("replace kept existing message 'bye'")
+	}
+	if got := b.GetMessage("en", "hello").GetSingular(); got != "Hi" {
+		t.Errorf("hello = %q, expected 'Hi'", got)
+	}
+}
+
+func TestBundle_LoadDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "ja"), 0o750); err != nil {
+		t.Fatal(err)
+	}
+	data := []byte(`{"messages": [{"id": "hello", "translation": "こんにちは"}]}`)
+	if err := os.WriteFile(filepath.Join(dir, "ja", "messages.json"), data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewBundle("en")
+	err := b.LoadDir(dir, "*/messages.json", WithLocaleFunc(func(name string) (string, error) {
+		return filepath.Dir(name), nil
+	}))
+	if err != nil {
+		t.Fatalf("LoadDir failed: %v", err)
+	}
+	if got := b.Localizer("ja-JP").T("hello"); got != "こんにちは" {
+		t.Errorf("T('hello') = %q, expected 'こんにちは'", got)
+	}
+}
+
+func TestDefaultBundle_EmbeddedLocales(t *testing.T) {
+	b := NewBundle("en")
+	if err := b.LoadFS(defaultLocales, "locales/*.json"); err != nil {
+		t.Fatalf("embedded locales failed to load: %v", err)
+	}
+	if got := len(b.AvailableLocales()); got != 6 {
+		t.Errorf("len(AvailableLocales()) = %d, expected 6", got)
+	}
+}
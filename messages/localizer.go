@@ -1,16 +1,23 @@
 package messages
 
 import (
+	"errors"
 	"fmt"
-	"regexp"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/grokify/structured-locale/locale"
 )
 
 // Localizer provides translation lookup for a specific locale.
 type Localizer struct {
-	bundle *Bundle
-	locale string
+	bundle     *Bundle
+	locale     string
+	normalized string    // normalized locale, empty if it cannot be parsed
+	chain      []string  // fallback chain of locale
+	hook       IssueHook // overrides the bundle's hook if set
 }
 
 // Locale returns the locale this localizer is configured for.
@@ -18,91 +25,458 @@ func (l *Localizer) Locale() string {
 	return l.locale
 }
 
+// Tag returns the parsed locale tag, including any Unicode locale extension
+// keywords such as hour cycle or first weekday that formatting code should
+// honor. Returns the zero Tag if the locale cannot be parsed.
+func (l *Localizer) Tag() locale.Tag {
+	t, _ := locale.Parse(l.locale, locale.WithCanonicalization())
+	return t
+}
+
 // T translates a message ID to the localized string.
 // Returns the ID itself if no translation is found.
 func (l *Localizer) T(id string) string {
-	m := l.bundle.GetMessage(l.locale, id)
-	if m == nil {
-		return id
-	}
-	return m.GetSingular()
+	r, _ := l.LocalizeResult(id, nil)
+	return r.Text
 }
 
 // Tn translates a plural message ID with count.
 // Returns the appropriate plural form based on the locale's plural rules.
+// {{.Count}} is replaced with the count.
 func (l *Localizer) Tn(id string, count int) string {
-	m := l.bundle.GetMessage(l.locale, id)
-	if m == nil {
-		return id
-	}
-
-	pt := m.GetPlural()
-	if pt == nil {
-		// Not a plural message, treat as singular
-		return l.Tf(id, map[string]any{"Count": count})
-	}
-
-	// Get the appropriate plural category for this locale and count
-	category := GetPluralCategory(l.locale, count)
-
-	// Select the translation for this category
-	var translation string
-	switch category {
-	case PluralZero:
-		translation = pt.Zero
-	case PluralOne:
-		translation = pt.One
-	case PluralTwo:
-		translation = pt.Two
-	case PluralFew:
-		translation = pt.Few
-	case PluralMany:
-		translation = pt.Many
-	default:
-		translation = pt.Other
+	r, _ := l.LocalizePluralResult(id, count, nil)
+	return r.Text
+}
+
+// Tf translates with template data.
+// Variables in the format {{.Name}} are replaced with corresponding values.
+// Variables missing from data are left unchanged.
+//
+// data is a map with string keys or a struct, so domain objects can be
+// passed directly. A struct's exported fields are variables, named by an
+// i18n struct tag if present:
+//
+//	type Invite struct {
+//		Sender string `i18n:"Name"`
+//		Team   *Team  // formatted by its String or Localize method
+//		token  string // unexported fields are ignored
+//	}
+//
+// Values implementing Localizable are formatted by their Localize method,
+// and fmt.Stringer values by their String method.
+func (l *Localizer) Tf(id string, data any) string {
+	r, _ := l.LocalizeResult(id, data)
+	return r.Text
+}
+
+// Result is a localized message together with the locale it came from.
+type Result struct {
+	Text     string
+	Locale   string // normalized locale the message was found in
+	Fallback bool   // true if Locale is not the localizer's own locale
+}
+
+// Localize translates a message ID like Tf, but reports problems instead
+// of hiding them: a *MessageNotFoundError if no locale in the fallback
+// chain defines the message, or a *MissingVariableError if data lacks a
+// value for a placeholder. Data of an unsupported type returns an error
+// wrapping ErrUnsupportedData. On error, the returned string is what Tf
+// would return. data may be nil.
+func (l *Localizer) Localize(id string, data any) (string, error) {
+	r, err := l.LocalizeResult(id, data)
+	return r.Text, err
+}
+
+// LocalizePlural translates a plural message ID like Tn, substituting
+// {{.Count}} and any variables in data. In addition to the errors returned
+// by Localize, it returns a *PluralFormMissingError if the message has no
+// form for the count's plural category; the "other" form is used instead.
+func (l *Localizer) LocalizePlural(id string, count int, data any) (string, error) {
+	r, err := l.LocalizePluralResult(id, count, data)
+	return r.Text, err
+}
+
+// LocalizeResult is like Localize but also reports which locale served the
+// message, so callers can detect fallback translations.
+func (l *Localizer) LocalizeResult(id string, data any) (Result, error) {
+	vars, dataErr := l.vars(data)
+	r, err := l.localize(id, vars)
+	if dataErr != nil {
+		err = errors.Join(dataErr, err)
+	}
+	l.report(id, r, err)
+	return r, err
+}
+
+// localize implements LocalizeResult without reporting issues.
+func (l *Localizer) localize(id string, data map[string]any) (Result, error) {
+	m, r, err := l.lookup(id)
+	if err != nil {
+		return r, err
+	}
+	return l.substitute(r, m, m.GetSingular(), templateData{vars: data})
+}
+
+// LocalizePluralResult is like LocalizePlural but also reports which locale
+// served the message.
+func (l *Localizer) LocalizePluralResult(id string, count int, data any) (Result, error) {
+	vars, dataErr := l.vars(data)
+	r, err := l.localizePlural(id, count, vars)
+	if dataErr != nil {
+		err = errors.Join(dataErr, err)
+	}
+	l.report(id, r, err)
+	return r, err
+}
+
+// localizePlural implements LocalizePluralResult without reporting issues.
+func (l *Localizer) localizePlural(id string, count int, data map[string]any) (Result, error) {
+	m, r, err := l.lookup(id)
+	if err != nil {
+		return r, err
 	}
 
-	// Fall back to "other" if the category is empty
+	vars := templateData{vars: data}.with("Count", count)
+	return l.pluralize(r, id, m, IntOperands(int64(count)), vars)
+}
+
+// pluralize fills in the Result text from the plural form of m for a
+// number, using ordinal categories for ordinal messages. Messages that are
+// not plural are substituted as they are.
+func (l *Localizer) pluralize(r Result, id string, m *Message, op PluralOperands, vars templateData) (Result, error) {
+	forms, ok := m.pluralForms()
+	if !ok {
+		return l.substitute(r, m, m.GetSingular(), vars)
+	}
+
+	rules := l.pluralRules(r.Locale)
+	category := rules.cardinalCategory(op)
+	if m.Ordinal {
+		category = PluralOther
+		if op.V == 0 && op.I <= math.MaxInt32 {
+			category = rules.ordinalCategory(int(op.I))
+		}
+	}
+	return l.pluralForm(r, m, forms, category, vars)
+}
+
+// pluralRules returns the plural rules of a locale, cached on its
+// MessageSet if the bundle has one.
+func (l *Localizer) pluralRules(loc string) pluralRules {
+	if ms := l.bundle.messageSet(loc); ms != nil {
+		return ms.plurals
+	}
+	return lookupPluralRules(loc)
+}
+
+// pluralForm fills in the Result text from the form of forms, the plural
+// translations of m, for a plural category, falling back to the "other"
+// form if it is missing.
+func (l *Localizer) pluralForm(r Result, m *Message, forms map[string]any, category PluralCategory, vars templateData) (Result, error) {
+	translation, _ := forms[string(category)].(string)
 	if translation == "" {
-		translation = pt.Other
+		formErr := &PluralFormMissingError{ID: m.ID, Locale: r.Locale, Category: category}
+		other, _ := forms[string(PluralOther)].(string)
+		r, err := l.substitute(r, m, other, vars)
+		if err != nil {
+			return r, errors.Join(formErr, err)
+		}
+		return r, formErr
 	}
+	return l.substitute(r, m, translation, vars)
+}
 
-	// Substitute {{.Count}}
-	return substituteVars(translation, map[string]any{"Count": count})
+// Tq translates a plural message for a quantity that may be fractional,
+// given as an integer, a float or a decimal string such as "1.5" or "1.0".
+// Visible fraction digits in strings select the form as CLDR specifies, so
+// in English "1" hour is singular but "1.0" hours is plural. {{.Count}} is
+// replaced with the quantity as given.
+func (l *Localizer) Tq(id string, quantity any) string {
+	r, _ := l.LocalizeQuantityResult(id, quantity, nil)
+	return r.Text
 }
 
-// Tf translates with template data.
-// Variables in the format {{.Name}} are replaced with corresponding values.
-func (l *Localizer) Tf(id string, data map[string]any) string {
-	m := l.bundle.GetMessage(l.locale, id)
-	if m == nil {
-		return id
+// LocalizeQuantity is like Tq, substituting any variables in data, but
+// returns the errors described for LocalizePlural. An invalid quantity
+// returns an error wrapping ErrInvalidQuantity with the "other" form.
+func (l *Localizer) LocalizeQuantity(id string, quantity, data any) (string, error) {
+	r, err := l.LocalizeQuantityResult(id, quantity, data)
+	return r.Text, err
+}
+
+// LocalizeQuantityResult is like LocalizeQuantity but also reports which
+// locale served the message.
+func (l *Localizer) LocalizeQuantityResult(id string, quantity, data any) (Result, error) {
+	vars, dataErr := l.vars(data)
+	r, err := l.localizeQuantity(id, quantity, vars)
+	if dataErr != nil {
+		err = errors.Join(dataErr, err)
+	}
+	l.report(id, r, err)
+	return r, err
+}
+
+// localizeQuantity implements LocalizeQuantityResult without reporting issues.
+func (l *Localizer) localizeQuantity(id string, quantity any, data map[string]any) (Result, error) {
+	m, r, err := l.lookup(id)
+	if err != nil {
+		return r, err
+	}
+
+	vars := templateData{vars: data}.with("Count", quantity)
+
+	op, opErr := NewPluralOperands(quantity)
+	if opErr != nil {
+		r, err := l.substitute(r, m, m.GetSingular(), vars)
+		return r, errors.Join(fmt.Errorf("%s: %w", id, opErr), err)
+	}
+	return l.pluralize(r, id, m, op, vars)
+}
+
+// Tord translates an ordinal message for a rank n, choosing the form by
+// the locale's CLDR ordinal rules, such as the English "two" form
+// "{{.Count}}nd" for 22. {{.Count}} is replaced with n. The message's
+// plural forms are read as ordinal categories even if it is not marked as
+// ordinal.
+func (l *Localizer) Tord(id string, n int) string {
+	r, _ := l.LocalizeOrdinalResult(id, n, nil)
+	return r.Text
+}
+
+// LocalizeOrdinal is like Tord, substituting any variables in data, but
+// returns the errors described for LocalizePlural.
+func (l *Localizer) LocalizeOrdinal(id string, n int, data any) (string, error) {
+	r, err := l.LocalizeOrdinalResult(id, n, data)
+	return r.Text, err
+}
+
+// LocalizeOrdinalResult is like LocalizeOrdinal but also reports which
+// locale served the message.
+func (l *Localizer) LocalizeOrdinalResult(id string, n int, data any) (Result, error) {
+	vars, dataErr := l.vars(data)
+	r, err := l.localizeOrdinal(id, n, vars)
+	if dataErr != nil {
+		err = errors.Join(dataErr, err)
+	}
+	l.report(id, r, err)
+	return r, err
+}
+
+// localizeOrdinal implements LocalizeOrdinalResult without reporting issues.
+func (l *Localizer) localizeOrdinal(id string, n int, data map[string]any) (Result, error) {
+	m, r, err := l.lookup(id)
+	if err != nil {
+		return r, err
+	}
+
+	vars := templateData{vars: data}.with("Count", n)
+
+	ordinal := *m
+	ordinal.Ordinal = true
+	return l.pluralize(r, id, &ordinal, IntOperands(int64(n)), vars)
+}
+
+// Trange translates a plural message for a range of counts, such as
+// "{{.Start}}–{{.End}} days", choosing the form by the locale's CLDR plural
+// range rules. {{.Start}} and {{.End}} are replaced with the bounds.
+func (l *Localizer) Trange(id string, start, end int) string {
+	r, _ := l.LocalizeRangeResult(id, start, end, nil)
+	return r.Text
+}
+
+// LocalizeRange is like Trange, substituting any variables in data, but
+// returns the errors described for LocalizePlural.
+func (l *Localizer) LocalizeRange(id string, start, end int, data any) (string, error) {
+	r, err := l.LocalizeRangeResult(id, start, end, data)
+	return r.Text, err
+}
+
+// LocalizeRangeResult is like LocalizeRange but also reports which locale
+// served the message.
+func (l *Localizer) LocalizeRangeResult(id string, start, end int, data any) (Result, error) {
+	vars, dataErr := l.vars(data)
+	r, err := l.localizeRange(id, start, end, vars)
+	if dataErr != nil {
+		err = errors.Join(dataErr, err)
+	}
+	l.report(id, r, err)
+	return r, err
+}
+
+// localizeRange implements LocalizeRangeResult without reporting issues.
+func (l *Localizer) localizeRange(id string, start, end int, data map[string]any) (Result, error) {
+	m, r, err := l.lookup(id)
+	if err != nil {
+		return r, err
+	}
+
+	vars := templateData{vars: data}.with("Start", start).with("End", end)
+	forms, ok := m.pluralForms()
+	if !ok {
+		return l.substitute(r, m, m.GetSingular(), vars)
+	}
+	category := l.pluralRules(r.Locale).rangeCategoryCounts(start, end)
+	return l.pluralForm(r, m, forms, category, vars)
+}
+
+// Ts translates a select message, choosing the variant from the value in
+// data of the argument named by the message's select field, or the "other"
+// variant if there is no match. If the variant has plural forms, the form
+// is chosen by data["Count"]. Variables are substituted as by Tf. A
+// Localizable select argument is matched by its String method, not by its
+// translation.
+func (l *Localizer) Ts(id string, data any) string {
+	r, _ := l.LocalizeSelectResult(id, data)
+	return r.Text
+}
+
+// LocalizeSelect is like Ts but returns the errors described for Localize
+// and LocalizePlural. A missing select argument or Count is reported as a
+// *MissingVariableError.
+func (l *Localizer) LocalizeSelect(id string, data any) (string, error) {
+	r, err := l.LocalizeSelectResult(id, data)
+	return r.Text, err
+}
+
+// LocalizeSelectResult is like LocalizeSelect but also reports which locale
+// served the message.
+func (l *Localizer) LocalizeSelectResult(id string, data any) (Result, error) {
+	args, dataErr := templateArgs(data)
+	r, err := l.localizeSelect(id, args)
+	if dataErr != nil {
+		err = errors.Join(dataErr, err)
 	}
-	return substituteVars(m.GetSingular(), data)
+	l.report(id, r, err)
+	return r, err
 }
 
-// templateVarPattern matches {{.VarName}} patterns.
-var templateVarPattern = regexp.MustCompile(`\{\{\s*\.(\w+)\s*\}\}`)
+// localizeSelect implements LocalizeSelectResult without reporting issues.
+// The select argument and Count are resolved from args as given, so a
+// Localizable value selects by its own value rather than its translation;
+// only substituted variables are formatted for l.
+func (l *Localizer) localizeSelect(id string, args map[string]any) (Result, error) {
+	m, r, err := l.lookup(id)
+	if err != nil {
+		return r, err
+	}
+
+	var missing []string
+	if m.IsSelect() {
+		var key string
+		if value, ok := lookupVar(args, m.Select); ok {
+			key = formatValue(value)
+		} else {
+			missing = append(missing, m.Select)
+		}
+		m = m.SelectVariant(key)
+	}
 
-// substituteVars replaces {{.Name}} patterns with values from data.
-func substituteVars(template string, data map[string]any) string {
-	return templateVarPattern.ReplaceAllStringFunc(template, func(match string) string {
-		// Extract variable name
-		submatch := templateVarPattern.FindStringSubmatch(match)
-		if len(submatch) < 2 {
-			return match
+	vars := templateData{vars: l.localizeVars(args)}
+	if m.IsPlural() {
+		count, ok := lookupVar(args, "Count")
+		op, opErr := NewPluralOperands(count)
+		if ok && opErr == nil {
+			r, err = l.pluralize(r, id, m, op, vars)
+		} else {
+			missing = append(missing, "Count")
+			r, err = l.substitute(r, m, m.GetSingular(), vars)
 		}
-		varName := submatch[1]
+	} else {
+		r, err = l.substitute(r, m, m.GetSingular(), vars)
+	}
 
-		// Look up value (case-insensitive for flexibility)
-		for k, v := range data {
-			if strings.EqualFold(k, varName) {
-				return formatValue(v)
+	if len(missing) == 0 {
+		return r, err
+	}
+	var mv *MissingVariableError
+	if errors.As(err, &mv) {
+		for _, name := range mv.Names {
+			if !slices.Contains(missing, name) {
+				missing = append(missing, name)
 			}
 		}
+		mv.Names = missing
+		return r, err
+	}
+	return r, errors.Join(err, &MissingVariableError{ID: id, Names: missing})
+}
+
+// Format translates a message ID whose translation is an ICU MessageFormat
+// pattern, formatting it with args using the plural rules and number
+// format of the locale that served the message. Errors are reported as by
+// Localize; a pattern that cannot be parsed returns an error wrapping
+// ErrMessageFormatSyntax or ErrArgumentType together with the raw
+// translation.
+func (l *Localizer) Format(id string, args map[string]any) (string, error) {
+	r, err := l.format(id, args)
+	l.report(id, r, err)
+	return r.Text, err
+}
+
+// format implements Format without reporting issues.
+func (l *Localizer) format(id string, args map[string]any) (Result, error) {
+	m, r, err := l.lookup(id)
+	if err != nil {
+		return r, err
+	}
+
+	pattern := m.GetSingular()
+	mf, err := l.bundle.messageFormat(pattern)
+	if err != nil {
+		r.Text = pattern
+		return r, fmt.Errorf("%s: %w", id, err)
+	}
+
+	text, err := mf.Format(r.Locale, args)
+	var missing *MissingVariableError
+	switch {
+	case errors.As(err, &missing):
+		missing.ID = id
+	case err != nil:
+		r.Text = pattern
+		return r, fmt.Errorf("%s: %w", id, err)
+	}
+	r.Text = text
+	return r, err
+}
+
+// lookup finds a message along the fallback chain. If it is not found, the
+// Result holds the ID as text and the error is a *MessageNotFoundError.
+func (l *Localizer) lookup(id string) (*Message, Result, error) {
+	m, found := l.bundle.findInChain(l.chain, id)
+	if m == nil {
+		return nil, Result{Text: id}, &MessageNotFoundError{ID: id, Chain: slices.Clone(l.chain)}
+	}
+	return m, Result{Locale: found, Fallback: l.normalized == "" || found != l.normalized}, nil
+}
+
+// substitute fills in the Result text from a template of m, reporting any
+// placeholders without a value.
+func (l *Localizer) substitute(r Result, m *Message, template string, data templateData) (Result, error) {
+	if c := l.bundle.templates.Load(); c != nil {
+		return l.execute(r, c, m, template, data.asMap())
+	}
+
+	var missing []string
+	r.Text, missing = m.template(template).render(&data)
+	if len(missing) > 0 {
+		return r, &MissingVariableError{ID: m.ID, Names: missing}
+	}
+	return r, nil
+}
 
-		return match // Keep original if not found
-	})
+// lookupVar returns the value of a template variable, matching names
+// case-insensitively for flexibility.
+func lookupVar(data map[string]any, name string) (any, bool) {
+	if v, ok := data[name]; ok {
+		return v, true
+	}
+	for k, v := range data {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
 }
 
 // formatValue converts a value to string for template substitution.
@@ -1,6 +1,8 @@
 package messages
 
 import (
+	"errors"
+	"slices"
 	"testing"
 )
 
@@ -105,3 +107,180 @@ func TestLocalizer_Japanese_Plural(t *testing.T) {
 		t.Errorf("Japanese Tn('plural.releases', 5) = %q, expected '5件のリリース'", got)
 	}
 }
+
+func TestLocalizer_Tag(t *testing.T) {
+	b := DefaultBundle()
+	l := b.Localizer("en-US-u-hc-h23-fw-mon")
+
+	if got := l.Tag().HourCycle(); got != "h23" {
+		t.Errorf("Tag().HourCycle() = %q, expected 'h23'", got)
+	}
+	// Keywords do not prevent falling back to the base locale
+	if got := l.T("changelog.title"); got != "Changelog" {
+		t.Errorf("T('changelog.title') = %q, expected 'Changelog'", got)
+	}
+}
+
+func TestLocalizer_Localize(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "hello", "translation": "Hello, {{.Name}}"},
+		{"id": "only_en", "translation": "English only"},
+		{"id": "files", "translation": {"one": "{{.Count}} file", "other": "{{.Count}} files"}}
+	]}`))
+	_ = b.AddLocale("fr", []byte(`{"messages": [
+		{"id": "hello", "translation": "Bonjour, {{.Name}}"},
+		{"id": "files", "translation": {"other": "{{.Count}} fichiers"}}
+	]}`))
+	l := b.Localizer("fr-CA")
+
+	got, err := l.Localize("hello", map[string]any{"Name": "Ana"})
+	if err != nil || got != "Bonjour, Ana" {
+		t.Errorf("Localize(hello) = %q, %v; expected 'Bonjour, Ana', nil", got, err)
+	}
+
+	// Missing message
+	got, err = l.Localize("nonexistent", nil)
+	var notFound *MessageNotFoundError
+	if !errors.As(err, &notFound) || !errors.Is(err, ErrMessageNotFound) {
+		t.Fatalf("Localize(nonexistent) error = %v, expected MessageNotFoundError", err)
+	}
+	if got != "nonexistent" {
+		t.Errorf("Localize(nonexistent) = %q, expected the ID", got)
+	}
+	if expected := []string{"fr-CA", "fr", "en"}; !slices.Equal(notFound.Chain, expected) {
+		t.Errorf("Chain = %v, expected %v", notFound.Chain, expected)
+	}
+
+	// Missing variable keeps the placeholder
+	got, err = l.Localize("hello", nil)
+	var missing *MissingVariableError
+	if !errors.As(err, &missing) || !slices.Equal(missing.Names, []string{"Name"}) {
+		t.Errorf("Localize(hello, nil) error = %v, expected MissingVariableError for Name", err)
+	}
+	if got != "Bonjour, {{.Name}}" {
+		t.Errorf("Localize(hello, nil) = %q, expected placeholder kept", got)
+	}
+}
+
+func TestLocalizer_LocalizeResult(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "only_en", "translation": "English only"}]}`))
+	_ = b.AddLocale("fr", []byte(`{"messages": [{"id": "hello", "translation": "Bonjour"}]}`))
+
+	tests := []struct {
+		locale   string
+		id       string
+		expected Result
+	}{
+		{"fr", "hello", Result{Text: "Bonjour", Locale: "fr"}},
+		{"fr-CA", "hello", Result{Text: "Bonjour", Locale: "fr", Fallback: true}},
+		{"fr", "only_en", Result{Text: "English only", Locale: "en", Fallback: true}},
+		{"en", "only_en", Result{Text: "English only", Locale: "en"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.locale+"/"+tt.id, func(t *testing.T) {
+			got, err := b.Localizer(tt.locale).LocalizeResult(tt.id, nil)
+			if err != nil {
+				t.Fatalf("LocalizeResult failed: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("LocalizeResult = %+v, expected %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLocalizer_LocalizePlural(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "files", "translation": {"one": "{{.Count}} file in {{.Dir}}", "other": "{{.Count}} files in {{.Dir}}"}}
+	]}`))
+	_ = b.AddLocale("ru", []byte(`{"messages": [
+		{"id": "files", "translation": {"one": "{{.Count}} файл", "other": "{{.Count}} файла"}}
+	]}`))
+
+	got, err := b.Localizer("en").LocalizePlural("files", 1, map[string]any{"Dir": "docs"})
+	if err != nil || got != "1 file in docs" {
+		t.Errorf("LocalizePlural(files, 1) = %q, %v; expected '1 file in docs', nil", got, err)
+	}
+
+	// The count takes precedence over a Count in data, which is left as is
+	data := map[string]any{"count": 9, "Dir": "docs"}
+	got, err = b.Localizer("en").LocalizePlural("files", 2, data)
+	if err != nil || got != "2 files in docs" || data["count"] != 9 || len(data) != 2 {
+		t.Errorf("LocalizePlural(files, 2) = %q, %v, data %v; expected '2 files in docs', data unchanged", got, err, data)
+	}
+
+	// Russian 5 selects "many", which is missing
+	got, err = b.Localizer("ru").LocalizePlural("files", 5, nil)
+	var formErr *PluralFormMissingError
+	if !errors.As(err, &formErr) || formErr.Category != PluralMany || formErr.Locale != "ru" {
+		t.Errorf("LocalizePlural(files, 5) error = %v, expected PluralFormMissingError for many", err)
+	}
+	if got != "5 файла" {
+		t.Errorf("LocalizePlural(files, 5) = %q, expected other form", got)
+	}
+}
+
+func TestLocalizer_Ts(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("fr", []byte(`{"messages": [
+		{"id": "profile.updated", "select": "Gender", "translation": {
+			"female": "{{.Name}} a mis à jour son profil (elle)",
+			"male": "{{.Name}} a mis à jour son profil (il)",
+			"other": "{{.Name}} a mis à jour son profil"
+		}},
+		{"id": "files.shared", "select": "Gender", "translation": {
+			"female": {"one": "Elle a partagé {{.Count}} fichier", "other": "Elle a partagé {{.Count}} fichiers"},
+			"other": {"one": "{{.Count}} fichier partagé", "other": "{{.Count}} fichiers partagés"}
+		}}
+	]}`))
+	l := b.Localizer("fr")
+
+	tests := []struct {
+		id       string
+		data     map[string]any
+		expected string
+	}{
+		{"profile.updated", map[string]any{"Gender": "female", "Name": "Ana"}, "Ana a mis à jour son profil (elle)"},
+		{"profile.updated", map[string]any{"gender": "male", "Name": "Luc"}, "Luc a mis à jour son profil (il)"},
+		{"profile.updated", map[string]any{"Gender": "nonbinary", "Name": "Sam"}, "Sam a mis à jour son profil"},
+		{"files.shared", map[string]any{"Gender": "female", "Count": 1}, "Elle a partagé 1 fichier"},
+		{"files.shared", map[string]any{"Gender": "female", "Count": 3}, "Elle a partagé 3 fichiers"},
+		{"files.shared", map[string]any{"Gender": "male", "Count": 3}, "3 fichiers partagés"},
+	}
+
+	for _, tt := range tests {
+		got := l.Ts(tt.id, tt.data)
+		if got != tt.expected {
+			t.Errorf("Ts(%q, %v) = %q, expected %q", tt.id, tt.data, got, tt.expected)
+		}
+	}
+
+	// Missing select argument uses "other" and is reported
+	got, err := l.LocalizeSelect("profile.updated", map[string]any{"Name": "Sam"})
+	var missing *MissingVariableError
+	if !errors.As(err, &missing) || !slices.Equal(missing.Names, []string{"Gender"}) {
+		t.Errorf("LocalizeSelect error = %v, expected MissingVariableError for Gender", err)
+	}
+	if got != "Sam a mis à jour son profil" {
+		t.Errorf("LocalizeSelect = %q, expected other variant", got)
+	}
+
+	// A missing Count is reported once, though the form also uses it
+	_, err = l.LocalizeSelect("files.shared", map[string]any{"Gender": "x"})
+	if !errors.As(err, &missing) || !slices.Equal(missing.Names, []string{"Count"}) {
+		t.Errorf("LocalizeSelect(files.shared) error = %v, expected MissingVariableError for Count", err)
+	}
+
+	// Select messages are not plural and read as their "other" variant
+	m := b.GetMessage("fr", "profile.updated")
+	if !m.IsSelect() || m.IsPlural() || m.GetPlural() != nil {
+		t.Errorf("IsSelect = %v, IsPlural = %v; expected a select message", m.IsSelect(), m.IsPlural())
+	}
+	if got := l.T("profile.updated"); got != "{{.Name}} a mis à jour son profil" {
+		t.Errorf("T(profile.updated) = %q, expected other variant", got)
+	}
+}
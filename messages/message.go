@@ -4,12 +4,14 @@ package messages
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 )
 
 // MessageSet holds messages for a single locale.
 type MessageSet struct {
 	tag      string
 	messages map[string]*Message
+	plurals  pluralRules // plural rules of tag
 }
 
 // NewMessageSet creates an empty MessageSet for the given locale tag.
@@ -17,6 +19,7 @@ func NewMessageSet(tag string) *MessageSet {
 	return &MessageSet{
 		tag:      tag,
 		messages: make(map[string]*Message),
+		plurals:  lookupPluralRules(tag),
 	}
 }
 
@@ -25,15 +28,48 @@ func (ms *MessageSet) Get(id string) *Message {
 	return ms.messages[id]
 }
 
-// Set adds or updates a message.
+// Set adds or updates a message. The set stores a copy of m with its
+// translation precompiled for fast formatting, so m itself is not modified.
 func (ms *MessageSet) Set(m *Message) {
-	ms.messages[m.ID] = m
+	c := *m
+	c.compile()
+	ms.messages[m.ID] = &c
+}
+
+// clone returns a copy of the MessageSet that can be modified without
+// affecting the original. Messages themselves are shared.
+func (ms *MessageSet) clone() *MessageSet {
+	return &MessageSet{
+		tag:      ms.tag,
+		messages: maps.Clone(ms.messages),
+		plurals:  ms.plurals,
+	}
 }
 
 // Message represents a single translatable message.
+//
+// A select message names an argument in Select and maps its values, such as
+// "female", "male" and "other", to variants in Translation. Each variant is
+// a string or a PluralTranslations map, so select can be combined with
+// plural forms.
+//
+// An ordinal message keys its plural forms by CLDR ordinal categories, as
+// in "1st", "2nd", "3rd" and "4th", instead of cardinal ones.
+//
+// Description, Hash, LeftDelim and RightDelim carry the go-i18n message
+// fields of the same names. LeftDelim and RightDelim replace "{{" and "}}"
+// around variables in the translation.
 type Message struct {
 	ID          string `json:"id"`
-	Translation any    `json:"translation"` // string or PluralTranslations map
+	Description string `json:"description,omitempty"` // context for translators
+	Hash        string `json:"hash,omitempty"`        // hash of the source message
+	LeftDelim   string `json:"leftDelim,omitempty"`   // variable delimiters, default "{{"
+	RightDelim  string `json:"rightDelim,omitempty"`  // and "}}"
+	Select      string `json:"select,omitempty"`      // argument choosing a variant
+	Ordinal     bool   `json:"ordinal,omitempty"`     // plural forms are ordinal categories
+	Translation any    `json:"translation"`           // string, PluralTranslations or select variants map
+
+	compiled map[string]*compiledTemplate // translation strings, precompiled by MessageSet.Set
 }
 
 // PluralTranslations holds CLDR plural category translations.
@@ -49,12 +85,44 @@ type PluralTranslations struct {
 // IsPlural returns true if this message has plural translations.
 func (m *Message) IsPlural() bool {
 	_, ok := m.Translation.(map[string]any)
-	return ok
+	return ok && m.Select == ""
+}
+
+// IsSelect returns true if this message has select variants.
+func (m *Message) IsSelect() bool {
+	_, ok := m.Translation.(map[string]any)
+	return ok && m.Select != ""
+}
+
+// SelectVariant returns the variant of a select message for a value of its
+// Select argument as a message of its own, falling back to the "other"
+// variant. Messages that are not select messages are returned unchanged.
+func (m *Message) SelectVariant(value string) *Message {
+	if !m.IsSelect() {
+		return m
+	}
+	variants := m.Translation.(map[string]any)
+	v, ok := variants[value]
+	if !ok {
+		v = variants["other"]
+	}
+	return &Message{
+		ID:          m.ID,
+		LeftDelim:   m.LeftDelim,
+		RightDelim:  m.RightDelim,
+		Ordinal:     m.Ordinal,
+		Translation: v,
+		compiled:    m.compiled,
+	}
 }
 
 // GetSingular returns the translation as a simple string.
-// For plural messages, returns the "other" form.
+// For plural messages, returns the "other" form; for select messages, the
+// singular of the "other" variant.
 func (m *Message) GetSingular() string {
+	if m.IsSelect() {
+		return m.SelectVariant("other").GetSingular()
+	}
 	switch v := m.Translation.(type) {
 	case string:
 		return v
@@ -69,7 +137,7 @@ func (m *Message) GetSingular() string {
 // GetPlural returns the plural translations, or nil if not plural.
 func (m *Message) GetPlural() *PluralTranslations {
 	v, ok := m.Translation.(map[string]any)
-	if !ok {
+	if !ok || m.Select != "" {
 		return nil
 	}
 
@@ -95,17 +163,75 @@ func (m *Message) GetPlural() *PluralTranslations {
 	return pt
 }
 
+// pluralForms returns the plural translations of m by category name, as
+// GetPlural does without copying them.
+func (m *Message) pluralForms() (map[string]any, bool) {
+	v, ok := m.Translation.(map[string]any)
+	return v, ok && m.Select == ""
+}
+
+// Form returns the translation for a plural category, or an empty string
+// if the category has none.
+func (pt *PluralTranslations) Form(category PluralCategory) string {
+	switch category {
+	case PluralZero:
+		return pt.Zero
+	case PluralOne:
+		return pt.One
+	case PluralTwo:
+		return pt.Two
+	case PluralFew:
+		return pt.Few
+	case PluralMany:
+		return pt.Many
+	default:
+		return pt.Other
+	}
+}
+
 // MessagesFile represents the JSON structure for a messages file.
-// This format is compatible with go-i18n.
 type MessagesFile struct {
 	Messages []Message `json:"messages"`
 }
 
-// ParseMessagesJSON parses a go-i18n compatible JSON messages file.
+// ParseMessagesJSON parses a JSON messages file. Besides this package's
+// {"messages": [...]} format, it accepts the formats of go-i18n:
+//
+//   - a flat map of IDs to translations: {"hello": "Hello"}
+//   - messages as objects with "description", "hash", "leftDelim",
+//     "rightDelim" and plural form keys from "zero" to "other"
+//   - nested ID namespaces: {"nav": {"home": "Home"}} defines "nav.home"
+//   - the go-i18n v1 array: [{"id": "hello", "translation": "Hello"}]
 func ParseMessagesJSON(data []byte) (*MessagesFile, error) {
-	var mf MessagesFile
-	if err := json.Unmarshal(data, &mf); err != nil {
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
 		return nil, fmt.Errorf("parsing messages JSON: %w", err)
 	}
+
+	var mf MessagesFile
+	switch v := raw.(type) {
+	case map[string]any:
+		// A "messages" key that is an array or null is this package's
+		// format; go-i18n translations are strings or objects
+		messages, ok := v["messages"]
+		if _, isArray := messages.([]any); !ok || messages != nil && !isArray {
+			msgs, err := parseGoI18n(v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing messages JSON: %w", err)
+			}
+			mf.Messages = msgs
+			return &mf, nil
+		}
+		if err := json.Unmarshal(data, &mf); err != nil {
+			return nil, fmt.Errorf("parsing messages JSON: %w", err)
+		}
+	case []any:
+		if err := json.Unmarshal(data, &mf.Messages); err != nil {
+			return nil, fmt.Errorf("parsing messages JSON: %w", err)
+		}
+	case nil:
+	default:
+		return nil, fmt.Errorf("parsing messages JSON: expected an object or array, got %T", raw)
+	}
 	return &mf, nil
 }
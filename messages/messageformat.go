@@ -0,0 +1,630 @@
+package messages
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/grokify/structured-locale/locale"
+)
+
+// ErrMessageFormatSyntax is returned when an ICU MessageFormat pattern
+// cannot be parsed.
+var ErrMessageFormatSyntax = errors.New("invalid message format")
+
+// ErrArgumentType is returned when a MessageFormat argument has a value of
+// the wrong type, such as a string for a plural argument.
+var ErrArgumentType = errors.New("invalid message argument type")
+
+// MessageFormat is a parsed ICU MessageFormat pattern, such as
+//
+//	{count, plural, one {# file} other {# files}} in {folder}
+//
+// Supported arguments are simple {name} arguments; number, date and time
+// arguments with a style; plural and selectordinal with exact =N cases,
+// an offset and # for the formatted number; and select. The spellout,
+// ordinal and duration types are not supported and fail to parse; use
+// selectordinal for ordinals. Apostrophes quote syntax characters as in
+// ICU: '{' is a literal brace, and a doubled apostrophe is a literal
+// apostrophe.
+//
+// A MessageFormat is immutable and safe for concurrent use.
+type MessageFormat struct {
+	nodes []mfNode
+}
+
+// mfNode is a part of a parsed message.
+type mfNode any
+
+// mfText is literal text.
+type mfText string
+
+// mfPound is # inside a plural case: the plural number minus the offset.
+type mfPound struct{}
+
+// mfArg is a simple, number, date or time argument.
+type mfArg struct {
+	name  string
+	typ   string // empty for a simple argument
+	style string
+}
+
+// mfPlural is a plural or selectordinal argument.
+type mfPlural struct {
+	name    string
+	ordinal bool
+	offset  float64
+	cases   []mfCase
+}
+
+// mfSelect is a select argument.
+type mfSelect struct {
+	name  string
+	cases []mfCase
+}
+
+// mfCase is a selector and its sub-message.
+type mfCase struct {
+	key   string // "=N", a plural category or a select keyword
+	nodes []mfNode
+}
+
+// ParseMessageFormat parses an ICU MessageFormat pattern. Errors wrap
+// ErrMessageFormatSyntax and give the byte offset of the problem.
+func ParseMessageFormat(pattern string) (*MessageFormat, error) {
+	p := &mfParser{src: pattern}
+	nodes, err := p.parseMessage(false, false)
+	if err != nil {
+		return nil, err
+	}
+	return &MessageFormat{nodes: nodes}, nil
+}
+
+// mfParser is a recursive descent parser for MessageFormat patterns.
+type mfParser struct {
+	src string
+	pos int
+}
+
+// errorf returns a syntax error at the current position.
+func (p *mfParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: %s at offset %d", ErrMessageFormatSyntax, fmt.Sprintf(format, args...), p.pos)
+}
+
+// parseMessage parses text and arguments up to the end of the pattern, or
+// up to the closing brace of a sub-message when nested is true.
+func (p *mfParser) parseMessage(inPlural, nested bool) ([]mfNode, error) {
+	var nodes []mfNode
+	var text strings.Builder
+	flush := func() {
+		if text.Len() > 0 {
+			nodes = append(nodes, mfText(text.String()))
+			text.Reset()
+		}
+	}
+
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch {
+		case c == '}':
+			if !nested {
+				return nil, p.errorf("unmatched '}'")
+			}
+			flush()
+			return nodes, nil
+		case c == '{':
+			flush()
+			arg, err := p.parseArgument(inPlural)
+			if err != nil {
+				return nil, err
+			}
+			nodes = append(nodes, arg)
+		case c == '#' && inPlural:
+			flush()
+			nodes = append(nodes, mfPound{})
+			p.pos++
+		case c == '\'':
+			text.WriteString(p.parseApostrophe(inPlural))
+		default:
+			text.WriteByte(c)
+			p.pos++
+		}
+	}
+
+	if nested {
+		return nil, p.errorf("unclosed '{'")
+	}
+	flush()
+	return nodes, nil
+}
+
+// parseApostrophe handles an apostrophe at the current position: a doubled
+// apostrophe is literal, an apostrophe before a syntax character starts quoted
+// literal text up to the next single apostrophe, and any other apostrophe
+// is literal.
+func (p *mfParser) parseApostrophe(inPlural bool) string {
+	p.pos++ // opening apostrophe
+	if p.pos >= len(p.src) {
+		return "'"
+	}
+	if c := p.src[p.pos]; c == '\'' {
+		p.pos++
+		return "'"
+	} else if c != '{' && c != '}' && c != '|' && (c != '#' || !inPlural) {
+		return "'"
+	}
+
+	var quoted strings.Builder
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		p.pos++
+		if c != '\'' {
+			quoted.WriteByte(c)
+			continue
+		}
+		if p.pos < len(p.src) && p.src[p.pos] == '\'' {
+			quoted.WriteByte('\'')
+			p.pos++
+			continue
+		}
+		break
+	}
+	return quoted.String()
+}
+
+// parseArgument parses an argument starting at '{'.
+func (p *mfParser) parseArgument(inPlural bool) (mfNode, error) {
+	p.pos++ // '{'
+	p.skipSpace()
+	name := p.parseWord()
+	if name == "" {
+		return nil, p.errorf("missing argument name")
+	}
+	p.skipSpace()
+
+	if p.consume('}') {
+		return mfArg{name: name}, nil
+	}
+	if !p.consume(',') {
+		return nil, p.errorf("expected ',' or '}' after argument %q", name)
+	}
+	p.skipSpace()
+	typ := p.parseWord()
+	p.skipSpace()
+
+	switch typ {
+	case "plural", "selectordinal":
+		if !p.consume(',') {
+			return nil, p.errorf("expected ',' after %s", typ)
+		}
+		return p.parsePlural(name, typ == "selectordinal")
+	case "select":
+		if !p.consume(',') {
+			return nil, p.errorf("expected ',' after select")
+		}
+		cases, err := p.parseCases(inPlural, false)
+		if err != nil {
+			return nil, err
+		}
+		return mfSelect{name: name, cases: cases}, nil
+	case "number", "date", "time":
+		arg := mfArg{name: name, typ: typ}
+		if p.consume(',') {
+			start := p.pos
+			for p.pos < len(p.src) && p.src[p.pos] != '}' {
+				if p.src[p.pos] == '{' {
+					return nil, p.errorf("unexpected '{' in argument style")
+				}
+				p.pos++
+			}
+			arg.style = strings.TrimSpace(p.src[start:p.pos])
+		}
+		if !p.consume('}') {
+			return nil, p.errorf("unclosed argument %q", name)
+		}
+		return arg, nil
+	case "spellout", "ordinal", "duration":
+		// Formatting these as plain numbers would print "22" for "22nd"
+		return nil, p.errorf("unsupported argument type %q", typ)
+	case "":
+		return nil, p.errorf("missing argument type")
+	default:
+		return nil, p.errorf("unknown argument type %q", typ)
+	}
+}
+
+// parsePlural parses the optional offset and the cases of a plural or
+// selectordinal argument.
+func (p *mfParser) parsePlural(name string, ordinal bool) (mfNode, error) {
+	pl := mfPlural{name: name, ordinal: ordinal}
+
+	p.skipSpace()
+	if strings.HasPrefix(p.src[p.pos:], "offset:") {
+		p.pos += len("offset:")
+		p.skipSpace()
+		word := p.parseWord()
+		offset, err := strconv.ParseFloat(word, 64)
+		if err != nil || offset < 0 {
+			return nil, p.errorf("invalid plural offset %q", word)
+		}
+		pl.offset = offset
+	}
+
+	cases, err := p.parseCases(true, true)
+	if err != nil {
+		return nil, err
+	}
+	pl.cases = cases
+	return pl, nil
+}
+
+// parseCases parses "key {message}" pairs up to the closing brace of the
+// argument. Every argument must have an "other" case.
+func (p *mfParser) parseCases(inPlural, plural bool) ([]mfCase, error) {
+	var cases []mfCase
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.src) {
+			return nil, p.errorf("unclosed argument")
+		}
+		if p.consume('}') {
+			break
+		}
+
+		key := p.parseWord()
+		if key == "" {
+			return nil, p.errorf("missing selector")
+		}
+		if strings.HasPrefix(key, "=") {
+			if !plural {
+				return nil, p.errorf("explicit value %q outside plural", key)
+			}
+			if _, err := strconv.ParseFloat(key[1:], 64); err != nil {
+				return nil, p.errorf("invalid explicit value %q", key)
+			}
+		}
+		if slices.ContainsFunc(cases, func(c mfCase) bool { return c.key == key }) {
+			return nil, p.errorf("duplicate selector %q", key)
+		}
+
+		p.skipSpace()
+		if !p.consume('{') {
+			return nil, p.errorf("expected '{' after selector %q", key)
+		}
+		nodes, err := p.parseMessage(inPlural, true)
+		if err != nil {
+			return nil, err
+		}
+		p.pos++ // '}'
+		cases = append(cases, mfCase{key: key, nodes: nodes})
+	}
+
+	if !slices.ContainsFunc(cases, func(c mfCase) bool { return c.key == "other" }) {
+		return nil, p.errorf("missing 'other' case")
+	}
+	return cases, nil
+}
+
+// parseWord reads characters up to whitespace or a syntax character.
+func (p *mfParser) parseWord() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == '{' || c == '}' || c == ',' || c == '\'' || c == ' ' || c == '\t' || c == '\n' || c == '\r' {
+			break
+		}
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+// skipSpace skips whitespace.
+func (p *mfParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+// consume advances past c if it is the next character.
+func (p *mfParser) consume(c byte) bool {
+	if p.pos < len(p.src) && p.src[p.pos] == c {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+// Format formats the message for a locale with the given arguments.
+// Plural rules and number formatting follow the locale. Arguments missing
+// from args are left in the output as {name} and reported together in a
+// *MissingVariableError; values of the wrong type return an error wrapping
+// ErrArgumentType.
+func (mf *MessageFormat) Format(loc string, args map[string]any) (string, error) {
+	f := &mfFormatter{locale: loc, args: args}
+	var b strings.Builder
+	if err := f.format(&b, mf.nodes, nil); err != nil {
+		return "", err
+	}
+	if len(f.missing) > 0 {
+		return b.String(), &MissingVariableError{Names: f.missing}
+	}
+	return b.String(), nil
+}
+
+// mfFormatter holds the state of a single Format call.
+type mfFormatter struct {
+	locale  string
+	args    map[string]any
+	missing []string
+}
+
+// mfPoundValue is the number that # stands for in a plural case.
+type mfPoundValue struct {
+	n float64
+}
+
+// format writes nodes to b. pound is the innermost plural's number, or nil.
+func (f *mfFormatter) format(b *strings.Builder, nodes []mfNode, pound *mfPoundValue) error {
+	for _, node := range nodes {
+		switch n := node.(type) {
+		case mfText:
+			b.WriteString(string(n))
+		case mfPound:
+			if pound != nil {
+				b.WriteString(formatNumber(f.locale, pound.n, ""))
+			} else {
+				b.WriteByte('#')
+			}
+		case mfArg:
+			if err := f.formatArg(b, n); err != nil {
+				return err
+			}
+		case mfPlural:
+			if err := f.formatPlural(b, n); err != nil {
+				return err
+			}
+		case mfSelect:
+			v, ok := f.arg(n.name)
+			key := ""
+			if ok {
+				key = formatValue(v)
+			}
+			if err := f.format(b, selectCase(n.cases, key).nodes, pound); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// arg returns the value of an argument, recording it as missing if absent.
+func (f *mfFormatter) arg(name string) (any, bool) {
+	v, ok := f.args[name]
+	if !ok && !slices.Contains(f.missing, name) {
+		f.missing = append(f.missing, name)
+	}
+	return v, ok
+}
+
+// formatArg writes a simple, number, date or time argument.
+func (f *mfFormatter) formatArg(b *strings.Builder, a mfArg) error {
+	v, ok := f.arg(a.name)
+	if !ok {
+		b.WriteString("{" + a.name + "}")
+		return nil
+	}
+
+	switch a.typ {
+	case "":
+		b.WriteString(formatValue(v))
+	case "date", "time":
+		t, ok := v.(time.Time)
+		if !ok {
+			return fmt.Errorf("%w: %s argument %q is %T, not time.Time", ErrArgumentType, a.typ, a.name, v)
+		}
+		b.WriteString(formatDateTime(t, a.typ, a.style))
+	default:
+		n, ok := toFloat(v)
+		if !ok {
+			return fmt.Errorf("%w: %s argument %q is %T, not a number", ErrArgumentType, a.typ, a.name, v)
+		}
+		b.WriteString(formatNumber(f.locale, n, a.style))
+	}
+	return nil
+}
+
+// formatPlural selects and writes the case of a plural or selectordinal
+// argument. Exact =N cases match before plural categories.
+func (f *mfFormatter) formatPlural(b *strings.Builder, pl mfPlural) error {
+	v, ok := f.arg(pl.name)
+	if !ok {
+		return f.format(b, selectCase(pl.cases, "other").nodes, nil)
+	}
+	n, ok := toFloat(v)
+	if !ok {
+		return fmt.Errorf("%w: plural argument %q is %T, not a number", ErrArgumentType, pl.name, v)
+	}
+
+	for _, c := range pl.cases {
+		if exact, ok := strings.CutPrefix(c.key, "="); ok {
+			if e, _ := strconv.ParseFloat(exact, 64); e == n {
+				return f.format(b, c.nodes, &mfPoundValue{n: n - pl.offset})
+			}
+		}
+	}
+
+	// Without an offset, decimal strings keep their visible fraction digits
+	rel := n - pl.offset
+	op, err := NewPluralOperands(v)
+	if err != nil || pl.offset != 0 {
+		op, _ = NewPluralOperands(rel)
+	}
+	category := PluralOther
+	switch {
+	case !pl.ordinal:
+		category = GetPluralCategoryOperands(f.locale, op)
+	case op.V == 0 && op.I <= math.MaxInt32:
+		category = GetOrdinalCategory(f.locale, int(op.I))
+	}
+	return f.format(b, selectCase(pl.cases, string(category)).nodes, &mfPoundValue{n: rel})
+}
+
+// selectCase returns the case with the given key, or the "other" case.
+func selectCase(cases []mfCase, key string) mfCase {
+	var other mfCase
+	for _, c := range cases {
+		if c.key == key {
+			return c
+		}
+		if c.key == "other" {
+			other = c
+		}
+	}
+	return other
+}
+
+// toFloat converts a numeric argument value to float64. Numeric strings are
+// accepted as well.
+func toFloat(v any) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// numberSymbols holds the decimal and grouping separators of a language.
+type numberSymbols struct {
+	decimal string
+	group   string
+}
+
+// localeNumberSymbols lists separators for languages that differ from
+// English.
+var localeNumberSymbols = map[string]numberSymbols{
+	"de": {",", "."},
+	"es": {",", "."},
+	"it": {",", "."},
+	"nl": {",", "."},
+	"pt": {",", "."},
+	"id": {",", "."},
+	"tr": {",", "."},
+	"da": {",", "."},
+	"el": {",", "."},
+	"fr": {",", "\u202f"},
+	"ru": {",", "\u00a0"},
+	"uk": {",", "\u00a0"},
+	"pl": {",", "\u00a0"},
+	"cs": {",", "\u00a0"},
+	"sk": {",", "\u00a0"},
+	"sv": {",", "\u00a0"},
+	"fi": {",", "\u00a0"},
+	"no": {",", "\u00a0"},
+	"nb": {",", "\u00a0"},
+}
+
+// formatNumber formats a number with the locale's separators. Styles are
+// "integer" (rounded), "percent" (multiplied by 100) and the default, which
+// shows up to three fraction digits.
+func formatNumber(loc string, n float64, style string) string {
+	sym := numberSymbols{decimal: ".", group: ","}
+	if t, err := locale.Parse(loc, locale.WithCanonicalization()); err == nil {
+		if s, ok := localeNumberSymbols[t.Language]; ok {
+			sym = s
+		}
+	}
+
+	suffix := ""
+	switch style {
+	case "integer":
+		n = math.Round(n)
+	case "percent":
+		n = math.Round(n * 100)
+		suffix = "%"
+	default:
+		n = math.Round(n*1000) / 1000
+	}
+
+	s := strconv.FormatFloat(math.Abs(n), 'f', -1, 64)
+	intPart, frac, _ := strings.Cut(s, ".")
+
+	var b strings.Builder
+	if n < 0 {
+		b.WriteByte('-')
+	}
+	for i, c := range intPart {
+		if i > 0 && (len(intPart)-i)%3 == 0 {
+			b.WriteString(sym.group)
+		}
+		b.WriteRune(c)
+	}
+	if frac != "" {
+		b.WriteString(sym.decimal)
+		b.WriteString(frac)
+	}
+	b.WriteString(suffix)
+	return b.String()
+}
+
+// dateLayouts and timeLayouts map ICU styles to time layouts.
+var (
+	dateLayouts = map[string]string{
+		"short":  "1/2/06",
+		"medium": "Jan 2, 2006",
+		"long":   "January 2, 2006",
+		"full":   "Monday, January 2, 2006",
+	}
+	timeLayouts = map[string]string{
+		"short":  "3:04 PM",
+		"medium": "3:04:05 PM",
+		"long":   "3:04:05 PM MST",
+		"full":   "3:04:05 PM MST",
+	}
+)
+
+// formatDateTime formats a date or time argument. Styles short, medium
+// (the default), long and full use English patterns; any other style is
+// used as a time layout.
+func formatDateTime(t time.Time, typ, style string) string {
+	layouts := dateLayouts
+	if typ == "time" {
+		layouts = timeLayouts
+	}
+	if style == "" {
+		style = "medium"
+	}
+	if layout, ok := layouts[style]; ok {
+		return t.Format(layout)
+	}
+	return t.Format(style)
+}
@@ -0,0 +1,178 @@
+package messages
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestMessageFormat_Format(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		pattern  string
+		args     map[string]any
+		expected string
+	}{
+		{"text", "en", "Hello", nil, "Hello"},
+		{"simple", "en", "Hello, {name}!", map[string]any{"name": "Ana"}, "Hello, Ana!"},
+		{"plural one", "en", "{count, plural, one {# file} other {# files}} in {folder}",
+			map[string]any{"count": 1, "folder": "docs"}, "1 file in docs"},
+		{"plural other", "en", "{count, plural, one {# file} other {# files}} in {folder}",
+			map[string]any{"count": 1200, "folder": "docs"}, "1,200 files in docs"},
+		{"plural exact", "en", "{count, plural, =0 {No files} one {# file} other {# files}}",
+			map[string]any{"count": 0}, "No files"},
+		{"plural russian", "ru", "{n, plural, one {# файл} few {# файла} many {# файлов} other {# файла}}",
+			map[string]any{"n": 22}, "22 файла"},
+		{"plural offset", "en",
+			"{guests, plural, offset:1 =0 {Nobody} =1 {{host}} one {{host} and # other} other {{host} and # others}}",
+			map[string]any{"guests": 3, "host": "Ana"}, "Ana and 2 others"},
+		{"plural offset one", "en",
+			"{guests, plural, offset:1 =0 {Nobody} =1 {{host}} one {{host} and # other} other {{host} and # others}}",
+			map[string]any{"guests": 2, "host": "Ana"}, "Ana and 1 other"},
+		{"select", "en", "{gender, select, female {She} male {He} other {They}} replied",
+			map[string]any{"gender": "female"}, "She replied"},
+		{"select other", "en", "{gender, select, female {She} male {He} other {They}} replied",
+			map[string]any{"gender": "unknown"}, "They replied"},
+		{"nested", "en",
+			"{gender, select, female {{n, plural, one {She has # file} other {She has # files}}} other {{n, plural, one {They have # file} other {They have # files}}}}",
+			map[string]any{"gender": "female", "n": 3}, "She has 3 files"},
+		{"selectordinal", "en", "{pos, selectordinal, one {#st} two {#nd} few {#rd} other {#th}} place",
+			map[string]any{"pos": 22}, "22nd place"},
+		{"selectordinal 13", "en", "{pos, selectordinal, one {#st} two {#nd} few {#rd} other {#th}}",
+			map[string]any{"pos": 13}, "13th"},
+		{"number", "de", "{n, number}", map[string]any{"n": 1234567.891}, "1.234.567,891"},
+		{"number integer", "en", "{n, number, integer}", map[string]any{"n": 1234.5}, "1,235"},
+		{"number percent", "en", "{n, number, percent}", map[string]any{"n": 0.25}, "25%"},
+		{"number negative", "en", "{n, number}", map[string]any{"n": -1234}, "-1,234"},
+		{"date", "en", "{d, date, long}", map[string]any{"d": time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)}, "March 5, 2024"},
+		{"time", "en", "{d, time, short}", map[string]any{"d": time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)}, "2:30 PM"},
+		{"quoted brace", "en", "'{'{name}'}'", map[string]any{"name": "x"}, "{x}"},
+		{"apostrophe", "en", "It''s {name}'s", map[string]any{"name": "Ana"}, "It's Ana's"},
+		{"quoted pound", "en", "{n, plural, other {'#' #}}", map[string]any{"n": 5}, "# 5"},
+		{"pound outside plural", "en", "# {n}", map[string]any{"n": 5}, "# 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mf, err := ParseMessageFormat(tt.pattern)
+			if err != nil {
+				t.Fatalf("ParseMessageFormat(%q) failed: %v", tt.pattern, err)
+			}
+			got, err := mf.Format(tt.locale, tt.args)
+			if err != nil {
+				t.Fatalf("Format failed: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Format = %q, expected %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseMessageFormat_Errors(t *testing.T) {
+	patterns := []string{
+		"{",
+		"}",
+		"{name",
+		"{}",
+		"{n, plural, one {# file}}",
+		"{n, plural, one {a} one {b} other {c}}",
+		"{n, select, =1 {a} other {b}}",
+		"{n, plural, offset:x other {a}}",
+		"{n, foo}",
+		"{n, ordinal}",
+		"{n, spellout}",
+		"{n, duration}",
+		"{n, plural, other {unclosed}",
+		"{n, number, {x}}",
+	}
+
+	for _, pattern := range patterns {
+		t.Run(pattern, func(t *testing.T) {
+			_, err := ParseMessageFormat(pattern)
+			if !errors.Is(err, ErrMessageFormatSyntax) {
+				t.Errorf("ParseMessageFormat(%q) error = %v, expected ErrMessageFormatSyntax", pattern, err)
+			}
+		})
+	}
+}
+
+func TestMessageFormat_FormatErrors(t *testing.T) {
+	mf, err := ParseMessageFormat("{count, plural, one {# file} other {# files}} in {folder}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := mf.Format("en", map[string]any{"count": 2})
+	var missing *MissingVariableError
+	if !errors.As(err, &missing) || !slices.Equal(missing.Names, []string{"folder"}) {
+		t.Errorf("Format error = %v, expected MissingVariableError for folder", err)
+	}
+	if got != "2 files in {folder}" {
+		t.Errorf("Format = %q, expected placeholder kept", got)
+	}
+
+	if _, err := mf.Format("en", map[string]any{"count": "many", "folder": "x"}); !errors.Is(err, ErrArgumentType) {
+		t.Errorf("Format error = %v, expected ErrArgumentType", err)
+	}
+}
+
+func TestLocalizer_Format(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "files", "translation": "{count, plural, one {# file} other {# files}} in {folder}"},
+		{"id": "broken", "translation": "{count, plural, one {# file}}"}
+	]}`))
+	_ = b.AddLocale("fr", []byte(`{"messages": [
+		{"id": "files", "translation": "{count, plural, one {# fichier} other {# fichiers}} dans {folder}"}
+	]}`))
+
+	got, err := b.Localizer("fr").Format("files", map[string]any{"count": 0, "folder": "docs"})
+	if err != nil || got != "0 fichier dans docs" {
+		t.Errorf("Format(files) = %q, %v; expected '0 fichier dans docs', nil", got, err)
+	}
+
+	got, err = b.Localizer("fr").Format("files", map[string]any{"count": 1500, "folder": "docs"})
+	if err != nil || got != "1\u202f500 fichiers dans docs" {
+		t.Errorf("Format(files) = %q, %v; expected French grouping", got, err)
+	}
+
+	_, err = b.Localizer("en").Format("files", map[string]any{"count": 2})
+	var missing *MissingVariableError
+	if !errors.As(err, &missing) || missing.ID != "files" {
+		t.Errorf("Format error = %v, expected MissingVariableError for message files", err)
+	}
+
+	if _, err := b.Localizer("en").Format("broken", nil); !errors.Is(err, ErrMessageFormatSyntax) {
+		t.Errorf("Format(broken) error = %v, expected ErrMessageFormatSyntax", err)
+	}
+
+	if _, err := b.Localizer("en").Format("nonexistent", nil); !errors.Is(err, ErrMessageNotFound) {
+		t.Errorf("Format(nonexistent) error = %v, expected ErrMessageNotFound", err)
+	}
+}
+
+func TestLocalizer_FormatReload(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "files", "translation": "{count, plural, one {# file} other {# files}}"}]}`))
+	l := b.Localizer("en")
+	if got, _ := l.Format("files", map[string]any{"count": 2}); got != "2 files" {
+		t.Fatalf("Format(files) = %q", got)
+	}
+
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "files", "translation": "{count, plural, one {# document} other {# documents}}"}]}`))
+	if got, _ := l.Format("files", map[string]any{"count": 2}); got != "2 documents" {
+		t.Errorf("Format(files) after reload = %q", got)
+	}
+
+	var cached []string
+	b.formats.Range(func(k, _ any) bool {
+		cached = append(cached, k.(string))
+		return true
+	})
+	if len(cached) != 1 || cached[0] != "{count, plural, one {# document} other {# documents}}" {
+		t.Errorf("cached formats = %q, expected only the current pattern", cached)
+	}
+}
@@ -0,0 +1,147 @@
+package messages
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidQuantity is returned when a value cannot be used as a plural
+// quantity.
+var ErrInvalidQuantity = errors.New("invalid plural quantity")
+
+// maxOperandDigits limits integer and fraction digits to what fits in an
+// int64. Longer numbers are rejected rather than truncated, since rules
+// such as "i = 1" compare the whole integer part.
+const maxOperandDigits = 18
+
+// PluralOperands are the CLDR plural operands of a number, as defined by
+// UTS #35. For "1.50": N = 1.5, I = 1, V = 2, W = 1, F = 50, T = 5.
+// Operands describe the absolute value of the number.
+type PluralOperands struct {
+	N float64 // absolute value
+	I int64   // integer digits
+	V int     // number of visible fraction digits, with trailing zeros
+	W int     // number of visible fraction digits, without trailing zeros
+	F int64   // visible fraction digits, with trailing zeros
+	T int64   // visible fraction digits, without trailing zeros
+	E int     // compact decimal exponent, as in "1.2c6"; also known as c
+}
+
+// IntOperands returns the plural operands of an integer.
+func IntOperands(n int64) PluralOperands {
+	if n < 0 {
+		n = -n
+	}
+	return PluralOperands{N: float64(n), I: n}
+}
+
+// NewPluralOperands returns the plural operands of an integer, a float or
+// a decimal string. Floats use their shortest decimal representation, so
+// 1.0 has no fraction digits; pass the string "1.0" to keep them.
+func NewPluralOperands(v any) (PluralOperands, error) {
+	switch n := v.(type) {
+	case int:
+		return IntOperands(int64(n)), nil
+	case int8:
+		return IntOperands(int64(n)), nil
+	case int16:
+		return IntOperands(int64(n)), nil
+	case int32:
+		return IntOperands(int64(n)), nil
+	case int64:
+		return IntOperands(n), nil
+	case uint:
+		return ParsePluralOperands(strconv.FormatUint(uint64(n), 10))
+	case uint8:
+		return IntOperands(int64(n)), nil
+	case uint16:
+		return IntOperands(int64(n)), nil
+	case uint32:
+		return IntOperands(int64(n)), nil
+	case uint64:
+		return ParsePluralOperands(strconv.FormatUint(n, 10))
+	case float32:
+		return floatOperands(float64(n), 32)
+	case float64:
+		return floatOperands(n, 64)
+	case string:
+		return ParsePluralOperands(n)
+	}
+	return PluralOperands{}, fmt.Errorf("%w: %T", ErrInvalidQuantity, v)
+}
+
+// floatOperands returns the plural operands of a float.
+func floatOperands(f float64, bitSize int) (PluralOperands, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return PluralOperands{}, fmt.Errorf("%w: %v", ErrInvalidQuantity, f)
+	}
+	return ParsePluralOperands(strconv.FormatFloat(f, 'f', -1, bitSize))
+}
+
+// ParsePluralOperands parses the plural operands of a decimal string such
+// as "1", "-1.50", "1.2c6" or "1.2e6". Visible fraction digits, including
+// trailing zeros, are significant. The exponent shifts the decimal point
+// and is reported as E. Numbers with more than 18 integer or fraction
+// digits return an error wrapping ErrInvalidQuantity.
+func ParsePluralOperands(s string) (PluralOperands, error) {
+	invalid := func() (PluralOperands, error) {
+		return PluralOperands{}, fmt.Errorf("%w: %q", ErrInvalidQuantity, s)
+	}
+
+	num := strings.TrimPrefix(strings.TrimPrefix(s, "-"), "+")
+	var op PluralOperands
+	if i := strings.IndexAny(num, "ce"); i >= 0 {
+		e, err := strconv.Atoi(num[i+1:])
+		if err != nil || e < 0 || e > maxOperandDigits {
+			return invalid()
+		}
+		op.E = e
+		num = num[:i]
+	}
+
+	intPart, frac, hasPoint := strings.Cut(num, ".")
+	if intPart == "" || !isDigits(intPart) || (hasPoint && (frac == "" || !isDigits(frac))) {
+		return invalid()
+	}
+
+	// Apply the exponent by moving fraction digits into the integer part
+	shift := min(op.E, len(frac))
+	intPart += frac[:shift] + strings.Repeat("0", op.E-shift)
+	frac = frac[shift:]
+
+	intPart = strings.TrimLeft(intPart, "0")
+	if len(intPart) > maxOperandDigits || len(frac) > maxOperandDigits {
+		return invalid()
+	}
+
+	var err error
+	if intPart != "" {
+		if op.I, err = strconv.ParseInt(intPart, 10, 64); err != nil {
+			return invalid()
+		}
+	}
+	op.V = len(frac)
+	if frac != "" {
+		op.F, _ = strconv.ParseInt(frac, 10, 64)
+	}
+	trimmed := strings.TrimRight(frac, "0")
+	op.W = len(trimmed)
+	if trimmed != "" {
+		op.T, _ = strconv.ParseInt(trimmed, 10, 64)
+	}
+	op.N = float64(op.I) + float64(op.F)/math.Pow10(op.V)
+	return op, nil
+}
+
+// isDigits reports whether s consists only of ASCII digits.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,202 @@
+package messages
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParsePluralOperands(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected PluralOperands
+	}{
+		{"1", PluralOperands{N: 1, I: 1}},
+		{"1.0", PluralOperands{N: 1, I: 1, V: 1}},
+		{"1.00", PluralOperands{N: 1, I: 1, V: 2}},
+		{"1.3", PluralOperands{N: 1.3, I: 1, V: 1, W: 1, F: 3, T: 3}},
+		{"1.30", PluralOperands{N: 1.3, I: 1, V: 2, W: 1, F: 30, T: 3}},
+		{"1.03", PluralOperands{N: 1.03, I: 1, V: 2, W: 2, F: 3, T: 3}},
+		{"1.230", PluralOperands{N: 1.23, I: 1, V: 3, W: 2, F: 230, T: 23}},
+		{"-1.5", PluralOperands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5}},
+		{"1200000", PluralOperands{N: 1200000, I: 1200000}},
+		{"1.2c6", PluralOperands{N: 1200000, I: 1200000, E: 6}},
+		{"123c6", PluralOperands{N: 123000000, I: 123000000, E: 6}},
+		{"1.23c2", PluralOperands{N: 123, I: 123, E: 2}},
+		{"1.234c2", PluralOperands{N: 123.4, I: 123, V: 1, W: 1, F: 4, T: 4, E: 2}},
+		{"1.2e3", PluralOperands{N: 1200, I: 1200, E: 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParsePluralOperands(tt.input)
+			if err != nil {
+				t.Fatalf("ParsePluralOperands(%q) failed: %v", tt.input, err)
+			}
+			if got != tt.expected {
+				t.Errorf("ParsePluralOperands(%q) = %+v, expected %+v", tt.input, got, tt.expected)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"", "abc", "1.", ".5", "1.2.3", "1c", "1cx", "--1", "1000000000000000000001", "1.5e18"} {
+		if _, err := ParsePluralOperands(invalid); !errors.Is(err, ErrInvalidQuantity) {
+			t.Errorf("ParsePluralOperands(%q) error = %v, expected ErrInvalidQuantity", invalid, err)
+		}
+	}
+
+	// Too long to parse rather than truncated to a small number
+	if got := GetQuantityPluralCategory("en", "1000000000000000000001"); got != PluralOther {
+		t.Errorf("GetQuantityPluralCategory(en, 10^21+1) = %q, expected other", got)
+	}
+}
+
+func TestNewPluralOperands(t *testing.T) {
+	tests := []struct {
+		input    any
+		expected PluralOperands
+	}{
+		{5, PluralOperands{N: 5, I: 5}},
+		{int64(-3), PluralOperands{N: 3, I: 3}},
+		{uint8(7), PluralOperands{N: 7, I: 7}},
+		{1.5, PluralOperands{N: 1.5, I: 1, V: 1, W: 1, F: 5, T: 5}},
+		{1.0, PluralOperands{N: 1, I: 1}},
+		{float32(0.25), PluralOperands{N: 0.25, V: 2, W: 2, F: 25, T: 25}},
+		{"2.50", PluralOperands{N: 2.5, I: 2, V: 2, W: 1, F: 50, T: 5}},
+	}
+
+	for _, tt := range tests {
+		got, err := NewPluralOperands(tt.input)
+		if err != nil {
+			t.Fatalf("NewPluralOperands(%v) failed: %v", tt.input, err)
+		}
+		if got != tt.expected {
+			t.Errorf("NewPluralOperands(%v) = %+v, expected %+v", tt.input, got, tt.expected)
+		}
+	}
+
+	if _, err := NewPluralOperands(true); !errors.Is(err, ErrInvalidQuantity) {
+		t.Errorf("NewPluralOperands(true) error = %v, expected ErrInvalidQuantity", err)
+	}
+}
+
+// TestGetQuantityPluralCategory_CLDRSamples checks the sample values listed
+// for each category in CLDR plurals.xml.
+func TestGetQuantityPluralCategory_CLDRSamples(t *testing.T) {
+	samples := map[string]map[PluralCategory][]string{
+		"en": {
+			PluralOne:   {"1"},
+			PluralOther: {"0", "2", "16", "100", "1000", "0.0", "1.0", "1.5", "10.0", "100.0"},
+		},
+		"fr": {
+			PluralOne:   {"0", "1", "0.0", "0.5", "1.0", "1.5"},
+			PluralMany:  {"1000000", "1c6", "2c6", "3c6", "1.0000001c6", "1.1c6", "2.0000001c6"},
+			PluralOther: {"2", "17", "100", "1000", "1c3", "2c3", "2.0", "3.5", "10.0", "1000000.0", "1.0001c3"},
+		},
+		"es": {
+			PluralOne:   {"1", "1.0", "1.00"},
+			PluralMany:  {"1000000", "1c6", "2c6", "1.0000001c6"},
+			PluralOther: {"0", "2", "16", "0.0", "0.9", "1.1", "1.6", "1c3"},
+		},
+		"pt": {
+			PluralOne:   {"0", "1", "0.0", "1.5"},
+			PluralMany:  {"1000000", "1c6"},
+			PluralOther: {"2", "17", "2.0", "3.5"},
+		},
+		"pt-PT": {
+			PluralOne:   {"1"},
+			PluralOther: {"0", "2", "16", "0.0", "1.5"},
+		},
+		"it": {
+			PluralOne:   {"1"},
+			PluralMany:  {"1000000", "1c6"},
+			PluralOther: {"0", "2", "16", "0.0", "1.5"},
+		},
+		"da": {
+			PluralOne:   {"1", "0.1", "1.0", "1.6"},
+			PluralOther: {"0", "2", "16", "0.0", "2.0", "3.4"},
+		},
+		"he": {
+			PluralOne:   {"1", "0.0", "0.9", "0.05"},
+			PluralTwo:   {"2"},
+			PluralOther: {"0", "3", "17", "100", "1.0", "2.5", "10.0"},
+		},
+		"ru": {
+			PluralOne:   {"1", "21", "31", "101"},
+			PluralFew:   {"2", "4", "22", "24", "102"},
+			PluralMany:  {"0", "5", "19", "100", "111"},
+			PluralOther: {"0.0", "1.5", "2.0", "10.0", "100.0"},
+		},
+		"be": {
+			PluralOne:   {"1", "21", "1.0", "21.0"},
+			PluralFew:   {"2", "4", "2.0", "3.0"},
+			PluralMany:  {"0", "5", "19", "0.0", "5.0"},
+			PluralOther: {"0.1", "0.9", "1.1", "1.7", "10.1"},
+		},
+		"pl": {
+			PluralOne:   {"1"},
+			PluralFew:   {"2", "4", "22", "24"},
+			PluralMany:  {"0", "5", "19", "100"},
+			PluralOther: {"0.0", "1.5", "10.0"},
+		},
+		"cs": {
+			PluralOne:   {"1"},
+			PluralFew:   {"2", "3", "4"},
+			PluralMany:  {"0.0", "1.5", "10.0"},
+			PluralOther: {"0", "5", "19", "100"},
+		},
+		"ar": {
+			PluralZero:  {"0", "0.0", "0.00"},
+			PluralOne:   {"1", "1.0", "1.00"},
+			PluralTwo:   {"2", "2.0", "2.00"},
+			PluralFew:   {"3", "10", "103", "110", "3.0", "4.0"},
+			PluralMany:  {"11", "26", "111", "11.0", "12.0"},
+			PluralOther: {"100", "102", "200", "0.1", "0.9", "1.1", "1.7", "10.1", "100.0"},
+		},
+		"ja": {
+			PluralOther: {"0", "1", "15", "0.0", "1.0", "1.5"},
+		},
+	}
+
+	for loc, categories := range samples {
+		for expected, values := range categories {
+			for _, v := range values {
+				if got := GetQuantityPluralCategory(loc, v); got != expected {
+					t.Errorf("GetQuantityPluralCategory(%q, %q) = %q, expected %q", loc, v, got, expected)
+				}
+			}
+		}
+	}
+}
+
+func TestLocalizer_Tq(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "hours", "translation": {"one": "{{.Count}} hour", "other": "{{.Count}} hours"}}
+	]}`))
+	_ = b.AddLocale("ru", []byte(`{"messages": [
+		{"id": "hours", "translation": {"one": "{{.Count}} час", "few": "{{.Count}} часа", "many": "{{.Count}} часов", "other": "{{.Count}} часа"}}
+	]}`))
+
+	tests := []struct {
+		locale   string
+		quantity any
+		expected string
+	}{
+		{"en", 1, "1 hour"},
+		{"en", "1.0", "1.0 hours"},
+		{"en", 1.5, "1.5 hours"},
+		{"ru", 5, "5 часов"},
+		{"ru", 1.5, "1.5 часа"},
+		{"ru", "21", "21 час"},
+	}
+
+	for _, tt := range tests {
+		if got := b.Localizer(tt.locale).Tq("hours", tt.quantity); got != tt.expected {
+			t.Errorf("Tq(hours, %v) in %s = %q, expected %q", tt.quantity, tt.locale, got, tt.expected)
+		}
+	}
+
+	if _, err := b.Localizer("en").LocalizeQuantity("hours", "lots", nil); !errors.Is(err, ErrInvalidQuantity) {
+		t.Errorf("LocalizeQuantity error = %v, expected ErrInvalidQuantity", err)
+	}
+}
@@ -0,0 +1,10 @@
+package messages
+
+// GetOrdinalCategory returns the CLDR ordinal plural category for a rank
+// such as 1st, 2nd or 22nd in a locale. Ordinal categories choose between
+// forms like "1st", "2nd", "3rd" and "4th" in English; many languages,
+// including German, Spanish, Russian and Japanese, use "other" for every
+// rank. Negative numbers use the category of their absolute value.
+func GetOrdinalCategory(loc string, n int) PluralCategory {
+	return lookupPluralRules(loc).ordinalCategory(n)
+}
@@ -0,0 +1,120 @@
+package messages
+
+import (
+	"testing"
+)
+
+func TestGetOrdinalCategory(t *testing.T) {
+	tests := []struct {
+		locale   string
+		n        int
+		expected PluralCategory
+	}{
+		// English
+		{"en", 1, PluralOne},
+		{"en", 2, PluralTwo},
+		{"en", 3, PluralFew},
+		{"en", 4, PluralOther},
+		{"en", 11, PluralOther},
+		{"en", 12, PluralOther},
+		{"en", 13, PluralOther},
+		{"en", 21, PluralOne},
+		{"en", 22, PluralTwo},
+		{"en", 23, PluralFew},
+		{"en", 101, PluralOne},
+		{"en", 111, PluralOther},
+		{"en-GB", 22, PluralTwo},
+
+		// Welsh
+		{"cy", 0, PluralZero},
+		{"cy", 1, PluralOne},
+		{"cy", 2, PluralTwo},
+		{"cy", 3, PluralFew},
+		{"cy", 5, PluralMany},
+		{"cy", 7, PluralZero},
+		{"cy", 10, PluralOther},
+
+		// Italian
+		{"it", 8, PluralMany},
+		{"it", 11, PluralMany},
+		{"it", 80, PluralMany},
+		{"it", 800, PluralMany},
+		{"it", 1, PluralOther},
+		{"it", 18, PluralOther},
+
+		// Swedish
+		{"sv", 1, PluralOne},
+		{"sv", 2, PluralOne},
+		{"sv", 11, PluralOther},
+		{"sv", 12, PluralOther},
+		{"sv", 21, PluralOne},
+		{"sv", 3, PluralOther},
+
+		// French
+		{"fr", 1, PluralOne},
+		{"fr", 2, PluralOther},
+
+		// Hindi and Bengali
+		{"hi", 1, PluralOne},
+		{"hi", 3, PluralTwo},
+		{"hi", 4, PluralFew},
+		{"hi", 6, PluralMany},
+		{"hi", 5, PluralOther},
+		{"bn", 5, PluralOne},
+
+		// Languages without ordinal distinctions
+		{"de", 1, PluralOther},
+		{"es", 2, PluralOther},
+		{"ru", 3, PluralOther},
+		{"ja", 1, PluralOther},
+	}
+
+	for _, tt := range tests {
+		got := GetOrdinalCategory(tt.locale, tt.n)
+		if got != tt.expected {
+			t.Errorf("GetOrdinalCategory(%q, %d) = %q, expected %q", tt.locale, tt.n, got, tt.expected)
+		}
+	}
+}
+
+func TestLocalizer_Tord(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "finished", "ordinal": true, "translation": {
+			"one": "You finished {{.Count}}st",
+			"two": "You finished {{.Count}}nd",
+			"few": "You finished {{.Count}}rd",
+			"other": "You finished {{.Count}}th"
+		}}
+	]}`))
+	_ = b.AddLocale("fr", []byte(`{"messages": [
+		{"id": "finished", "ordinal": true, "translation": {
+			"one": "Vous avez terminé {{.Count}}er",
+			"other": "Vous avez terminé {{.Count}}e"
+		}}
+	]}`))
+
+	tests := []struct {
+		locale   string
+		n        int
+		expected string
+	}{
+		{"en", 1, "You finished 1st"},
+		{"en", 22, "You finished 22nd"},
+		{"en", 13, "You finished 13th"},
+		{"en", 103, "You finished 103rd"},
+		{"fr", 1, "Vous avez terminé 1er"},
+		{"fr", 2, "Vous avez terminé 2e"},
+	}
+
+	for _, tt := range tests {
+		l := b.Localizer(tt.locale)
+		if got := l.Tord("finished", tt.n); got != tt.expected {
+			t.Errorf("Tord(finished, %d) in %s = %q, expected %q", tt.n, tt.locale, got, tt.expected)
+		}
+		// Tn follows the message's ordinal flag
+		if got := l.Tn("finished", tt.n); got != tt.expected {
+			t.Errorf("Tn(finished, %d) in %s = %q, expected %q", tt.n, tt.locale, got, tt.expected)
+		}
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"github.com/grokify/structured-locale/locale"
 )
 
+//go:generate go run ./internal/genplurals
+
 // PluralCategory represents CLDR plural categories.
 type PluralCategory string
 
@@ -16,147 +18,101 @@ const (
 	PluralOther PluralCategory = "other"
 )
 
+// pluralRuleFunc returns the plural category of a number in one language.
+// The rule functions and their tables are generated from the CLDR plural
+// rules in internal/genplurals/cldr; see plural_tables.go.
+type pluralRuleFunc func(op PluralOperands) PluralCategory
+
 // GetPluralCategory returns the CLDR plural category for a count in a locale.
-// Implements common plural rules for major languages.
-// For unsupported locales, falls back to English rules (one/other).
+// Rules are generated from the CLDR plural data and cover every CLDR language.
+// For unknown locales, falls back to English rules (one/other).
 func GetPluralCategory(loc string, count int) PluralCategory {
-	// Parse to get the base language
-	t, err := locale.Parse(loc)
-	if err != nil {
-		return getPluralCategoryEnglish(count)
-	}
+	return GetPluralCategoryOperands(loc, IntOperands(int64(count)))
+}
 
-	// Dispatch based on language
-	switch t.Language {
-	// Languages with only "other" (no singular form)
-	case "ja", "ko", "zh", "vi", "th", "id", "ms":
+// GetQuantityPluralCategory returns the CLDR plural category for a quantity
+// given as an integer, a float or a decimal string such as "1.50". Visible
+// fraction digits in strings are significant: "1" and "1.0" may select
+// different categories. Invalid quantities use the "other" category.
+func GetQuantityPluralCategory(loc string, quantity any) PluralCategory {
+	op, err := NewPluralOperands(quantity)
+	if err != nil {
 		return PluralOther
-
-	// Languages with one/other (Germanic, Romance, etc.)
-	case "en", "de", "nl", "sv", "da", "no", "nb", "nn",
-		"es", "pt", "it", "ca", "eu",
-		"el", "he", "fi", "et", "hu", "tr":
-		return getPluralCategoryEnglish(count)
-
-	// French: 0 and 1 are singular
-	case "fr":
-		return getPluralCategoryFrench(count)
-
-	// Slavic languages (Russian, Ukrainian, Polish, etc.)
-	case "ru", "uk", "be":
-		return getPluralCategorySlavic(count)
-
-	// Polish has its own rules
-	case "pl":
-		return getPluralCategoryPolish(count)
-
-	// Czech and Slovak
-	case "cs", "sk":
-		return getPluralCategoryCzech(count)
-
-	// Arabic
-	case "ar":
-		return getPluralCategoryArabic(count)
-
-	default:
-		return getPluralCategoryEnglish(count)
 	}
+	return GetPluralCategoryOperands(loc, op)
 }
 
-// getPluralCategoryEnglish returns plural category for English-like languages.
-// one: n = 1
-// other: everything else
-func getPluralCategoryEnglish(n int) PluralCategory {
-	if n == 1 {
-		return PluralOne
-	}
-	return PluralOther
+// GetPluralCategoryOperands returns the CLDR plural category for a number,
+// given as plural operands, in a locale.
+func GetPluralCategoryOperands(loc string, op PluralOperands) PluralCategory {
+	return lookupPluralRules(loc).cardinalCategory(op)
 }
 
-// getPluralCategoryFrench returns plural category for French.
-// one: n = 0 or n = 1
-// other: everything else
-func getPluralCategoryFrench(n int) PluralCategory {
-	if n == 0 || n == 1 {
-		return PluralOne
-	}
-	return PluralOther
+// pluralRules are the cardinal, ordinal and range rules of a locale,
+// resolved once so that formatting does not parse the locale.
+type pluralRules struct {
+	cardinal pluralRuleFunc // nil for English-like rules
+	ordinal  pluralRuleFunc // nil if every rank is "other"
+	ranges   map[pluralRange]PluralCategory
 }
 
-// getPluralCategorySlavic returns plural category for Russian/Ukrainian/Belarusian.
-// one: n mod 10 = 1 and n mod 100 != 11
-// few: n mod 10 in 2..4 and n mod 100 not in 12..14
-// many: n mod 10 = 0 or n mod 10 in 5..9 or n mod 100 in 11..14
-// other: (fractional numbers, not applicable for integers)
-func getPluralCategorySlavic(n int) PluralCategory {
-	mod10 := n % 10
-	mod100 := n % 100
-
-	if mod10 == 1 && mod100 != 11 {
-		return PluralOne
+// lookupPluralRules resolves the plural rules of a locale. Invalid locales
+// get the English-like defaults.
+func lookupPluralRules(loc string) pluralRules {
+	t, err := locale.Parse(loc, locale.WithCanonicalization())
+	if err != nil {
+		return pluralRules{}
 	}
-	if mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14) {
-		return PluralFew
+	return pluralRules{
+		cardinal: lookupPluralRule(cardinalRules, t),
+		ordinal:  lookupPluralRule(ordinalRules, t),
+		ranges:   pluralRangeRules[t.Language],
 	}
-	return PluralMany
 }
 
-// getPluralCategoryPolish returns plural category for Polish.
-// one: n = 1
-// few: n mod 10 in 2..4 and n mod 100 not in 12..14
-// many: n != 1 and n mod 10 in 0..1 or n mod 10 in 5..9 or n mod 100 in 12..14
-func getPluralCategoryPolish(n int) PluralCategory {
-	if n == 1 {
-		return PluralOne
+// lookupPluralRule returns the rule for a locale, preferring regional rules
+// such as European Portuguese over those of the language. It returns nil
+// for languages without rules.
+func lookupPluralRule(rules map[string]pluralRuleFunc, t locale.Tag) pluralRuleFunc {
+	if t.Region != "" {
+		if rule, ok := rules[t.Language+"-"+t.Region]; ok {
+			return rule
+		}
 	}
+	return rules[t.Language]
+}
 
-	mod10 := n % 10
-	mod100 := n % 100
-
-	if mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14) {
-		return PluralFew
+// cardinalCategory returns the plural category of a number.
+func (p pluralRules) cardinalCategory(op PluralOperands) PluralCategory {
+	if p.cardinal != nil {
+		return p.cardinal(op)
 	}
-	return PluralMany
+	return getPluralCategoryEnglish(op)
 }
 
-// getPluralCategoryCzech returns plural category for Czech/Slovak.
-// one: n = 1
-// few: n in 2..4
-// other: everything else
-func getPluralCategoryCzech(n int) PluralCategory {
-	if n == 1 {
-		return PluralOne
-	}
-	if n >= 2 && n <= 4 {
-		return PluralFew
+// ordinalCategory returns the ordinal category of a rank.
+func (p pluralRules) ordinalCategory(n int) PluralCategory {
+	if p.ordinal != nil {
+		return p.ordinal(IntOperands(int64(n)))
 	}
 	return PluralOther
 }
 
-// getPluralCategoryArabic returns plural category for Arabic.
-// zero: n = 0
-// one: n = 1
-// two: n = 2
-// few: n mod 100 in 3..10
-// many: n mod 100 in 11..99
-// other: everything else
-func getPluralCategoryArabic(n int) PluralCategory {
-	if n == 0 {
-		return PluralZero
-	}
-	if n == 1 {
-		return PluralOne
-	}
-	if n == 2 {
-		return PluralTwo
+// rangeCategory returns the plural category of a range given the
+// categories of its start and end.
+func (p pluralRules) rangeCategory(start, end PluralCategory) PluralCategory {
+	if category, ok := p.ranges[pluralRange{start, end}]; ok {
+		return category
 	}
+	return end
+}
 
-	mod100 := n % 100
-	if mod100 >= 3 && mod100 <= 10 {
-		return PluralFew
-	}
-	if mod100 >= 11 {
-		return PluralMany
+// getPluralCategoryEnglish returns plural category for English-like languages.
+// one: i = 1 and v = 0
+// other: everything else, including "1.0"
+func getPluralCategoryEnglish(op PluralOperands) PluralCategory {
+	if op.I == 1 && op.V == 0 {
+		return PluralOne
 	}
 	return PluralOther
 }
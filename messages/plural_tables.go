@@ -0,0 +1,1560 @@
+// Code generated by genplurals from CLDR plurals.xml, ordinals.xml and pluralRanges.xml; DO NOT EDIT.
+
+package messages
+
+// cardinalRules maps languages, and locales with regional rules, to their
+// CLDR cardinal plural rules.
+var cardinalRules = map[string]pluralRuleFunc{
+	"af":    cardinalAf,
+	"ak":    cardinalAk,
+	"am":    cardinalAm,
+	"an":    cardinalAf,
+	"ar":    cardinalAr,
+	"ars":   cardinalAr,
+	"as":    cardinalAm,
+	"asa":   cardinalAf,
+	"ast":   cardinalAst,
+	"az":    cardinalAf,
+	"bal":   cardinalAf,
+	"be":    cardinalBe,
+	"bem":   cardinalAf,
+	"bez":   cardinalAf,
+	"bg":    cardinalAf,
+	"bho":   cardinalAk,
+	"bm":    cardinalBm,
+	"bn":    cardinalAm,
+	"bo":    cardinalBm,
+	"br":    cardinalBr,
+	"brx":   cardinalAf,
+	"bs":    cardinalBs,
+	"ca":    cardinalCa,
+	"ce":    cardinalAf,
+	"ceb":   cardinalCeb,
+	"cgg":   cardinalAf,
+	"chr":   cardinalAf,
+	"ckb":   cardinalAf,
+	"cs":    cardinalCs,
+	"cy":    cardinalCy,
+	"da":    cardinalDa,
+	"de":    cardinalAst,
+	"doi":   cardinalAm,
+	"dsb":   cardinalDsb,
+	"dv":    cardinalAf,
+	"dz":    cardinalBm,
+	"ee":    cardinalAf,
+	"el":    cardinalAf,
+	"en":    cardinalAst,
+	"eo":    cardinalAf,
+	"es":    cardinalEs,
+	"et":    cardinalAst,
+	"eu":    cardinalAf,
+	"fa":    cardinalAm,
+	"ff":    cardinalFf,
+	"fi":    cardinalAst,
+	"fil":   cardinalCeb,
+	"fo":    cardinalAf,
+	"fr":    cardinalFr,
+	"fur":   cardinalAf,
+	"fy":    cardinalAst,
+	"ga":    cardinalGa,
+	"gd":    cardinalGd,
+	"gl":    cardinalAst,
+	"gsw":   cardinalAf,
+	"gu":    cardinalAm,
+	"guw":   cardinalAk,
+	"gv":    cardinalGv,
+	"ha":    cardinalAf,
+	"haw":   cardinalAf,
+	"he":    cardinalHe,
+	"hi":    cardinalAm,
+	"hnj":   cardinalBm,
+	"hr":    cardinalBs,
+	"hsb":   cardinalDsb,
+	"hu":    cardinalAf,
+	"hy":    cardinalFf,
+	"ia":    cardinalAst,
+	"id":    cardinalBm,
+	"ig":    cardinalBm,
+	"ii":    cardinalBm,
+	"in":    cardinalBm,
+	"io":    cardinalAst,
+	"is":    cardinalIs,
+	"it":    cardinalCa,
+	"iu":    cardinalIu,
+	"iw":    cardinalHe,
+	"ja":    cardinalBm,
+	"jbo":   cardinalBm,
+	"jgo":   cardinalAf,
+	"ji":    cardinalAst,
+	"jmc":   cardinalAf,
+	"jv":    cardinalBm,
+	"jw":    cardinalBm,
+	"ka":    cardinalAf,
+	"kab":   cardinalFf,
+	"kaj":   cardinalAf,
+	"kcg":   cardinalAf,
+	"kde":   cardinalBm,
+	"kea":   cardinalBm,
+	"kk":    cardinalAf,
+	"kkj":   cardinalAf,
+	"kl":    cardinalAf,
+	"km":    cardinalBm,
+	"kn":    cardinalAm,
+	"ko":    cardinalBm,
+	"ks":    cardinalAf,
+	"ksb":   cardinalAf,
+	"ksh":   cardinalKsh,
+	"ku":    cardinalAf,
+	"kw":    cardinalKw,
+	"ky":    cardinalAf,
+	"lag":   cardinalLag,
+	"lb":    cardinalAf,
+	"lg":    cardinalAf,
+	"lij":   cardinalAst,
+	"lkt":   cardinalBm,
+	"lld":   cardinalCa,
+	"ln":    cardinalAk,
+	"lo":    cardinalBm,
+	"lt":    cardinalLt,
+	"lv":    cardinalLv,
+	"mas":   cardinalAf,
+	"mg":    cardinalAk,
+	"mgo":   cardinalAf,
+	"mk":    cardinalMk,
+	"ml":    cardinalAf,
+	"mn":    cardinalAf,
+	"mo":    cardinalMo,
+	"mr":    cardinalAf,
+	"ms":    cardinalBm,
+	"mt":    cardinalMt,
+	"my":    cardinalBm,
+	"nah":   cardinalAf,
+	"naq":   cardinalIu,
+	"nb":    cardinalAf,
+	"nd":    cardinalAf,
+	"ne":    cardinalAf,
+	"nl":    cardinalAst,
+	"nn":    cardinalAf,
+	"nnh":   cardinalAf,
+	"no":    cardinalAf,
+	"nqo":   cardinalBm,
+	"nr":    cardinalAf,
+	"nso":   cardinalAk,
+	"ny":    cardinalAf,
+	"nyn":   cardinalAf,
+	"om":    cardinalAf,
+	"or":    cardinalAf,
+	"os":    cardinalAf,
+	"osa":   cardinalBm,
+	"pa":    cardinalAk,
+	"pap":   cardinalAf,
+	"pcm":   cardinalAm,
+	"pl":    cardinalPl,
+	"prg":   cardinalLv,
+	"ps":    cardinalAf,
+	"pt":    cardinalPt,
+	"pt-PT": cardinalCa,
+	"rm":    cardinalAf,
+	"ro":    cardinalMo,
+	"rof":   cardinalAf,
+	"ru":    cardinalRu,
+	"rwk":   cardinalAf,
+	"sah":   cardinalBm,
+	"saq":   cardinalAf,
+	"sat":   cardinalIu,
+	"sc":    cardinalAst,
+	"scn":   cardinalCa,
+	"sd":    cardinalAf,
+	"sdh":   cardinalAf,
+	"se":    cardinalIu,
+	"seh":   cardinalAf,
+	"ses":   cardinalBm,
+	"sg":    cardinalBm,
+	"sh":    cardinalBs,
+	"shi":   cardinalShi,
+	"si":    cardinalSi,
+	"sk":    cardinalCs,
+	"sl":    cardinalSl,
+	"sma":   cardinalIu,
+	"smi":   cardinalIu,
+	"smj":   cardinalIu,
+	"smn":   cardinalIu,
+	"sms":   cardinalIu,
+	"sn":    cardinalAf,
+	"so":    cardinalAf,
+	"sq":    cardinalAf,
+	"sr":    cardinalBs,
+	"ss":    cardinalAf,
+	"ssy":   cardinalAf,
+	"st":    cardinalAf,
+	"su":    cardinalBm,
+	"sv":    cardinalAst,
+	"sw":    cardinalAst,
+	"syr":   cardinalAf,
+	"ta":    cardinalAf,
+	"te":    cardinalAf,
+	"teo":   cardinalAf,
+	"th":    cardinalBm,
+	"ti":    cardinalAk,
+	"tig":   cardinalAf,
+	"tk":    cardinalAf,
+	"tl":    cardinalCeb,
+	"tn":    cardinalAf,
+	"to":    cardinalBm,
+	"tpi":   cardinalBm,
+	"tr":    cardinalAf,
+	"ts":    cardinalAf,
+	"tzm":   cardinalTzm,
+	"ug":    cardinalAf,
+	"uk":    cardinalRu,
+	"ur":    cardinalAst,
+	"uz":    cardinalAf,
+	"ve":    cardinalAf,
+	"vec":   cardinalCa,
+	"vi":    cardinalBm,
+	"vo":    cardinalAf,
+	"vun":   cardinalAf,
+	"wa":    cardinalAk,
+	"wae":   cardinalAf,
+	"wo":    cardinalBm,
+	"xh":    cardinalAf,
+	"xog":   cardinalAf,
+	"yi":    cardinalAst,
+	"yo":    cardinalBm,
+	"yue":   cardinalBm,
+	"zh":    cardinalBm,
+	"zu":    cardinalAm,
+}
+
+// ordinalRules maps languages, and locales with regional rules, to their
+// CLDR ordinal plural rules.
+var ordinalRules = map[string]pluralRuleFunc{
+	"af":  ordinalAf,
+	"am":  ordinalAf,
+	"an":  ordinalAf,
+	"ar":  ordinalAf,
+	"as":  ordinalAs,
+	"az":  ordinalAz,
+	"bal": ordinalBal,
+	"be":  ordinalBe,
+	"bg":  ordinalAf,
+	"bn":  ordinalAs,
+	"bs":  ordinalAf,
+	"ca":  ordinalCa,
+	"ce":  ordinalAf,
+	"cs":  ordinalAf,
+	"cy":  ordinalCy,
+	"da":  ordinalAf,
+	"de":  ordinalAf,
+	"dsb": ordinalAf,
+	"el":  ordinalAf,
+	"en":  ordinalEn,
+	"es":  ordinalAf,
+	"et":  ordinalAf,
+	"eu":  ordinalAf,
+	"fa":  ordinalAf,
+	"fi":  ordinalAf,
+	"fil": ordinalBal,
+	"fr":  ordinalBal,
+	"fy":  ordinalAf,
+	"ga":  ordinalBal,
+	"gd":  ordinalGd,
+	"gl":  ordinalAf,
+	"gsw": ordinalAf,
+	"gu":  ordinalGu,
+	"he":  ordinalAf,
+	"hi":  ordinalGu,
+	"hr":  ordinalAf,
+	"hsb": ordinalAf,
+	"hu":  ordinalHu,
+	"hy":  ordinalBal,
+	"ia":  ordinalAf,
+	"id":  ordinalAf,
+	"in":  ordinalAf,
+	"is":  ordinalAf,
+	"it":  ordinalIt,
+	"iw":  ordinalAf,
+	"ja":  ordinalAf,
+	"ka":  ordinalKa,
+	"kk":  ordinalKk,
+	"km":  ordinalAf,
+	"kn":  ordinalAf,
+	"ko":  ordinalAf,
+	"kw":  ordinalKw,
+	"ky":  ordinalAf,
+	"lij": ordinalLij,
+	"lo":  ordinalBal,
+	"lt":  ordinalAf,
+	"lv":  ordinalAf,
+	"mk":  ordinalMk,
+	"ml":  ordinalAf,
+	"mn":  ordinalAf,
+	"mo":  ordinalBal,
+	"mr":  ordinalMr,
+	"ms":  ordinalBal,
+	"my":  ordinalAf,
+	"nb":  ordinalAf,
+	"ne":  ordinalNe,
+	"nl":  ordinalAf,
+	"no":  ordinalAf,
+	"or":  ordinalOr,
+	"pa":  ordinalAf,
+	"pl":  ordinalAf,
+	"prg": ordinalAf,
+	"ps":  ordinalAf,
+	"pt":  ordinalAf,
+	"ro":  ordinalBal,
+	"ru":  ordinalAf,
+	"sc":  ordinalIt,
+	"scn": ordinalIt,
+	"sd":  ordinalAf,
+	"sh":  ordinalAf,
+	"si":  ordinalAf,
+	"sk":  ordinalAf,
+	"sl":  ordinalAf,
+	"sq":  ordinalSq,
+	"sr":  ordinalAf,
+	"sv":  ordinalSv,
+	"sw":  ordinalAf,
+	"ta":  ordinalAf,
+	"te":  ordinalAf,
+	"th":  ordinalAf,
+	"tk":  ordinalTk,
+	"tl":  ordinalBal,
+	"tpi": ordinalAf,
+	"tr":  ordinalAf,
+	"uk":  ordinalUk,
+	"ur":  ordinalAf,
+	"uz":  ordinalAf,
+	"vi":  ordinalBal,
+	"yue": ordinalAf,
+	"zh":  ordinalAf,
+	"zu":  ordinalAf,
+}
+
+// pluralRangeRules maps languages to the CLDR plural categories of ranges.
+var pluralRangeRules = map[string]map[pluralRange]PluralCategory{
+	"af":  rangesAf,
+	"am":  rangesAm,
+	"ar":  rangesAr,
+	"az":  rangesAz,
+	"be":  rangesBe,
+	"bg":  rangesAf,
+	"bn":  rangesAm,
+	"bs":  rangesBs,
+	"ca":  rangesAf,
+	"cs":  rangesCs,
+	"cy":  rangesCy,
+	"da":  rangesDa,
+	"de":  rangesAz,
+	"el":  rangesAz,
+	"en":  rangesAf,
+	"es":  rangesAf,
+	"et":  rangesAf,
+	"eu":  rangesAf,
+	"fa":  rangesFa,
+	"fi":  rangesAf,
+	"fil": rangesDa,
+	"fr":  rangesAm,
+	"ga":  rangesGa,
+	"gl":  rangesAz,
+	"gu":  rangesAm,
+	"he":  rangesHe,
+	"hi":  rangesAm,
+	"hr":  rangesBs,
+	"hu":  rangesAz,
+	"hy":  rangesAm,
+	"id":  rangesId,
+	"is":  rangesDa,
+	"it":  rangesAz,
+	"ja":  rangesId,
+	"ka":  rangesKa,
+	"kk":  rangesAz,
+	"km":  rangesId,
+	"kn":  rangesAm,
+	"ko":  rangesId,
+	"ky":  rangesAz,
+	"lo":  rangesId,
+	"lt":  rangesBe,
+	"lv":  rangesLv,
+	"mk":  rangesMk,
+	"ml":  rangesAz,
+	"mn":  rangesAz,
+	"mr":  rangesAm,
+	"ms":  rangesId,
+	"my":  rangesId,
+	"nb":  rangesAf,
+	"ne":  rangesAz,
+	"nl":  rangesAz,
+	"pa":  rangesAm,
+	"pl":  rangesCs,
+	"pt":  rangesAz,
+	"ro":  rangesRo,
+	"ru":  rangesBe,
+	"si":  rangesSi,
+	"sk":  rangesCs,
+	"sl":  rangesSl,
+	"sq":  rangesAz,
+	"sr":  rangesBs,
+	"sv":  rangesDa,
+	"sw":  rangesAz,
+	"ta":  rangesAz,
+	"te":  rangesAz,
+	"th":  rangesId,
+	"tr":  rangesAz,
+	"ug":  rangesAz,
+	"uk":  rangesBe,
+	"ur":  rangesAf,
+	"uz":  rangesAz,
+	"vi":  rangesId,
+	"yue": rangesId,
+	"zh":  rangesId,
+	"zu":  rangesAm,
+}
+
+// cardinalBm implements the rules for bm, bo, dz, hnj, id, ig, ii, in, ja, jbo, jv, jw, kde, kea, km, ko, lkt, lo, ms, my, nqo, osa, sah, ses, sg, su, th, to, tpi, vi, wo, yo, yue, zh.
+func cardinalBm(op PluralOperands) PluralCategory {
+	return PluralOther
+}
+
+// cardinalAm implements the rules for am, as, bn, doi, fa, gu, hi, kn, pcm, zu.
+// one: i = 0 or n = 1
+func cardinalAm(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 0 ||
+		op.T == 0 && op.I == 1:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalFf implements the rules for ff, hy, kab.
+// one: i = 0,1
+func cardinalFf(op PluralOperands) PluralCategory {
+	switch {
+	case (op.I == 0 || op.I == 1):
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalAst implements the rules for ast, de, en, et, fi, fy, gl, ia, io, ji, lij, nl, sc, sv, sw, ur, yi.
+// one: i = 1 and v = 0
+func cardinalAst(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 1 && op.V == 0:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalSi implements the rules for si.
+// one: n = 0,1 or i = 0 and f = 1
+func cardinalSi(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 0 || op.I == 1) ||
+		op.I == 0 && op.F == 1:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalAk implements the rules for ak, bho, guw, ln, mg, nso, pa, ti, wa.
+// one: n = 0..1
+func cardinalAk(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I <= 1:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalTzm implements the rules for tzm.
+// one: n = 0..1 or n = 11..99
+func cardinalTzm(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I <= 1 ||
+		op.T == 0 && op.I >= 11 && op.I <= 99:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalAf implements the rules for af, an, asa, az, bal, bem, bez, bg, brx, ce, cgg, chr, ckb, dv, ee, el, eo, eu, fo, fur, gsw, ha, haw, hu, jgo, jmc, ka, kaj, kcg, kk, kkj, kl, ks, ksb, ku, ky, lb, lg, mas, mgo, ml, mn, mr, nah, nb, nd, ne, nn, nnh, no, nr, ny, nyn, om, or, os, pap, ps, rm, rof, rwk, saq, sd, sdh, seh, sn, so, sq, ss, ssy, st, syr, ta, te, teo, tig, tk, tn, tr, ts, ug, uz, ve, vo, vun, wae, xh, xog.
+// one: n = 1
+func cardinalAf(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalDa implements the rules for da.
+// one: n = 1 or t != 0 and i = 0,1
+func cardinalDa(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1 ||
+		op.T != 0 && (op.I == 0 || op.I == 1):
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalIs implements the rules for is.
+// one: t = 0 and i % 10 = 1 and i % 100 != 11 or t % 10 = 1 and t % 100 != 11
+func cardinalIs(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 1 && op.I%100 != 11 ||
+		op.T%10 == 1 && op.T%100 != 11:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalMk implements the rules for mk.
+// one: v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11
+func cardinalMk(op PluralOperands) PluralCategory {
+	switch {
+	case op.V == 0 && op.I%10 == 1 && op.I%100 != 11 ||
+		op.F%10 == 1 && op.F%100 != 11:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalCeb implements the rules for ceb, fil, tl.
+// one: v = 0 and i = 1,2,3 or v = 0 and i % 10 != 4,6,9 or v != 0 and f % 10 != 4,6,9
+func cardinalCeb(op PluralOperands) PluralCategory {
+	switch {
+	case op.V == 0 && (op.I == 1 || op.I == 2 || op.I == 3) ||
+		op.V == 0 && (op.I%10 != 4 && op.I%10 != 6 && op.I%10 != 9) ||
+		op.V != 0 && (op.F%10 != 4 && op.F%10 != 6 && op.F%10 != 9):
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalLv implements the rules for lv, prg.
+// zero: n % 10 = 0 or n % 100 = 11..19 or v = 2 and f % 100 = 11..19
+// one: n % 10 = 1 and n % 100 != 11 or v = 2 and f % 10 = 1 and f % 100 != 11 or v != 2 and f % 10 = 1
+func cardinalLv(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 0 ||
+		op.T == 0 && op.I%100 >= 11 && op.I%100 <= 19 ||
+		op.V == 2 && op.F%100 >= 11 && op.F%100 <= 19:
+		return PluralZero
+	case op.T == 0 && op.I%10 == 1 && op.I%100 != 11 ||
+		op.V == 2 && op.F%10 == 1 && op.F%100 != 11 ||
+		op.V != 2 && op.F%10 == 1:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalLag implements the rules for lag.
+// zero: n = 0
+// one: i = 0,1 and n != 0
+func cardinalLag(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 0:
+		return PluralZero
+	case (op.I == 0 || op.I == 1) && (op.T != 0 || op.I != 0):
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalKsh implements the rules for ksh.
+// zero: n = 0
+// one: n = 1
+func cardinalKsh(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 0:
+		return PluralZero
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// cardinalHe implements the rules for he, iw.
+// one: i = 1 and v = 0 or i = 0 and v != 0
+// two: i = 2 and v = 0
+func cardinalHe(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 1 && op.V == 0 ||
+		op.I == 0 && op.V != 0:
+		return PluralOne
+	case op.I == 2 && op.V == 0:
+		return PluralTwo
+	}
+	return PluralOther
+}
+
+// cardinalIu implements the rules for iu, naq, sat, se, sma, smi, smj, smn, sms.
+// one: n = 1
+// two: n = 2
+func cardinalIu(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I == 2:
+		return PluralTwo
+	}
+	return PluralOther
+}
+
+// cardinalShi implements the rules for shi.
+// one: i = 0 or n = 1
+// few: n = 2..10
+func cardinalShi(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 0 ||
+		op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I >= 2 && op.I <= 10:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// cardinalMo implements the rules for mo, ro.
+// one: i = 1 and v = 0
+// few: v != 0 or n = 0 or n != 1 and n % 100 = 1..19
+func cardinalMo(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 1 && op.V == 0:
+		return PluralOne
+	case op.V != 0 ||
+		op.T == 0 && op.I == 0 ||
+		op.T == 0 && op.I != 1 && op.I%100 >= 1 && op.I%100 <= 19:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// cardinalBs implements the rules for bs, hr, sh, sr.
+// one: v = 0 and i % 10 = 1 and i % 100 != 11 or f % 10 = 1 and f % 100 != 11
+// few: v = 0 and i % 10 = 2..4 and i % 100 != 12..14 or f % 10 = 2..4 and f % 100 != 12..14
+func cardinalBs(op PluralOperands) PluralCategory {
+	switch {
+	case op.V == 0 && op.I%10 == 1 && op.I%100 != 11 ||
+		op.F%10 == 1 && op.F%100 != 11:
+		return PluralOne
+	case op.V == 0 && op.I%10 >= 2 && op.I%10 <= 4 && (op.I%100 < 12 || op.I%100 > 14) ||
+		op.F%10 >= 2 && op.F%10 <= 4 && (op.F%100 < 12 || op.F%100 > 14):
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// cardinalFr implements the rules for fr.
+// one: i = 0,1
+// many: e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5
+func cardinalFr(op PluralOperands) PluralCategory {
+	switch {
+	case (op.I == 0 || op.I == 1):
+		return PluralOne
+	case op.E == 0 && op.I != 0 && op.I%1000000 == 0 && op.V == 0 ||
+		op.E > 5:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalPt implements the rules for pt.
+// one: i = 0..1
+// many: e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5
+func cardinalPt(op PluralOperands) PluralCategory {
+	switch {
+	case op.I <= 1:
+		return PluralOne
+	case op.E == 0 && op.I != 0 && op.I%1000000 == 0 && op.V == 0 ||
+		op.E > 5:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalCa implements the rules for ca, it, lld, pt-PT, scn, vec.
+// one: i = 1 and v = 0
+// many: e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5
+func cardinalCa(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 1 && op.V == 0:
+		return PluralOne
+	case op.E == 0 && op.I != 0 && op.I%1000000 == 0 && op.V == 0 ||
+		op.E > 5:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalEs implements the rules for es.
+// one: n = 1
+// many: e = 0 and i != 0 and i % 1000000 = 0 and v = 0 or e != 0..5
+func cardinalEs(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.E == 0 && op.I != 0 && op.I%1000000 == 0 && op.V == 0 ||
+		op.E > 5:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalGd implements the rules for gd.
+// one: n = 1,11
+// two: n = 2,12
+// few: n = 3..10,13..19
+func cardinalGd(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 1 || op.I == 11):
+		return PluralOne
+	case op.T == 0 && (op.I == 2 || op.I == 12):
+		return PluralTwo
+	case op.T == 0 && (op.I >= 3 && op.I <= 10 || op.I >= 13 && op.I <= 19):
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// cardinalSl implements the rules for sl.
+// one: v = 0 and i % 100 = 1
+// two: v = 0 and i % 100 = 2
+// few: v = 0 and i % 100 = 3..4 or v != 0
+func cardinalSl(op PluralOperands) PluralCategory {
+	switch {
+	case op.V == 0 && op.I%100 == 1:
+		return PluralOne
+	case op.V == 0 && op.I%100 == 2:
+		return PluralTwo
+	case op.V == 0 && op.I%100 >= 3 && op.I%100 <= 4 ||
+		op.V != 0:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// cardinalDsb implements the rules for dsb, hsb.
+// one: v = 0 and i % 100 = 1 or f % 100 = 1
+// two: v = 0 and i % 100 = 2 or f % 100 = 2
+// few: v = 0 and i % 100 = 3..4 or f % 100 = 3..4
+func cardinalDsb(op PluralOperands) PluralCategory {
+	switch {
+	case op.V == 0 && op.I%100 == 1 ||
+		op.F%100 == 1:
+		return PluralOne
+	case op.V == 0 && op.I%100 == 2 ||
+		op.F%100 == 2:
+		return PluralTwo
+	case op.V == 0 && op.I%100 >= 3 && op.I%100 <= 4 ||
+		op.F%100 >= 3 && op.F%100 <= 4:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// cardinalCs implements the rules for cs, sk.
+// one: i = 1 and v = 0
+// few: i = 2..4 and v = 0
+// many: v != 0
+func cardinalCs(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 1 && op.V == 0:
+		return PluralOne
+	case op.I >= 2 && op.I <= 4 && op.V == 0:
+		return PluralFew
+	case op.V != 0:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalPl implements the rules for pl.
+// one: i = 1 and v = 0
+// few: v = 0 and i % 10 = 2..4 and i % 100 != 12..14
+// many: v = 0 and i != 1 and i % 10 = 0..1 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 12..14
+func cardinalPl(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 1 && op.V == 0:
+		return PluralOne
+	case op.V == 0 && op.I%10 >= 2 && op.I%10 <= 4 && (op.I%100 < 12 || op.I%100 > 14):
+		return PluralFew
+	case op.V == 0 && op.I != 1 && op.I%10 <= 1 ||
+		op.V == 0 && op.I%10 >= 5 && op.I%10 <= 9 ||
+		op.V == 0 && op.I%100 >= 12 && op.I%100 <= 14:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalBe implements the rules for be.
+// one: n % 10 = 1 and n % 100 != 11
+// few: n % 10 = 2..4 and n % 100 != 12..14
+// many: n % 10 = 0 or n % 10 = 5..9 or n % 100 = 11..14
+func cardinalBe(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 1 && op.I%100 != 11:
+		return PluralOne
+	case op.T == 0 && op.I%10 >= 2 && op.I%10 <= 4 && (op.I%100 < 12 || op.I%100 > 14):
+		return PluralFew
+	case op.T == 0 && op.I%10 == 0 ||
+		op.T == 0 && op.I%10 >= 5 && op.I%10 <= 9 ||
+		op.T == 0 && op.I%100 >= 11 && op.I%100 <= 14:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalLt implements the rules for lt.
+// one: n % 10 = 1 and n % 100 != 11..19
+// few: n % 10 = 2..9 and n % 100 != 11..19
+// many: f != 0
+func cardinalLt(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 1 && (op.I%100 < 11 || op.I%100 > 19):
+		return PluralOne
+	case op.T == 0 && op.I%10 >= 2 && op.I%10 <= 9 && (op.I%100 < 11 || op.I%100 > 19):
+		return PluralFew
+	case op.F != 0:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalRu implements the rules for ru, uk.
+// one: v = 0 and i % 10 = 1 and i % 100 != 11
+// few: v = 0 and i % 10 = 2..4 and i % 100 != 12..14
+// many: v = 0 and i % 10 = 0 or v = 0 and i % 10 = 5..9 or v = 0 and i % 100 = 11..14
+func cardinalRu(op PluralOperands) PluralCategory {
+	switch {
+	case op.V == 0 && op.I%10 == 1 && op.I%100 != 11:
+		return PluralOne
+	case op.V == 0 && op.I%10 >= 2 && op.I%10 <= 4 && (op.I%100 < 12 || op.I%100 > 14):
+		return PluralFew
+	case op.V == 0 && op.I%10 == 0 ||
+		op.V == 0 && op.I%10 >= 5 && op.I%10 <= 9 ||
+		op.V == 0 && op.I%100 >= 11 && op.I%100 <= 14:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalBr implements the rules for br.
+// one: n % 10 = 1 and n % 100 != 11,71,91
+// two: n % 10 = 2 and n % 100 != 12,72,92
+// few: n % 10 = 3..4,9 and n % 100 != 10..19,70..79,90..99
+// many: n != 0 and n % 1000000 = 0
+func cardinalBr(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 1 && (op.I%100 != 11 && op.I%100 != 71 && op.I%100 != 91):
+		return PluralOne
+	case op.T == 0 && op.I%10 == 2 && (op.I%100 != 12 && op.I%100 != 72 && op.I%100 != 92):
+		return PluralTwo
+	case op.T == 0 && (op.I%10 >= 3 && op.I%10 <= 4 || op.I%10 == 9) && ((op.I%100 < 10 || op.I%100 > 19) && (op.I%100 < 70 || op.I%100 > 79) && (op.I%100 < 90 || op.I%100 > 99)):
+		return PluralFew
+	case op.T == 0 && op.I != 0 && op.I%1000000 == 0:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalGa implements the rules for ga.
+// one: n = 1
+// two: n = 2
+// few: n = 3..6
+// many: n = 7..10
+func cardinalGa(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I == 2:
+		return PluralTwo
+	case op.T == 0 && op.I >= 3 && op.I <= 6:
+		return PluralFew
+	case op.T == 0 && op.I >= 7 && op.I <= 10:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalGv implements the rules for gv.
+// one: v = 0 and i % 10 = 1
+// two: v = 0 and i % 10 = 2
+// few: v = 0 and i % 100 = 0,20,40,60,80
+// many: v != 0
+func cardinalGv(op PluralOperands) PluralCategory {
+	switch {
+	case op.V == 0 && op.I%10 == 1:
+		return PluralOne
+	case op.V == 0 && op.I%10 == 2:
+		return PluralTwo
+	case op.V == 0 && (op.I%100 == 0 || op.I%100 == 20 || op.I%100 == 40 || op.I%100 == 60 || op.I%100 == 80):
+		return PluralFew
+	case op.V != 0:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalMt implements the rules for mt.
+// one: n = 1
+// two: n = 2
+// few: n = 0 or n % 100 = 3..10
+// many: n % 100 = 11..19
+func cardinalMt(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I == 2:
+		return PluralTwo
+	case op.T == 0 && op.I == 0 ||
+		op.T == 0 && op.I%100 >= 3 && op.I%100 <= 10:
+		return PluralFew
+	case op.T == 0 && op.I%100 >= 11 && op.I%100 <= 19:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalKw implements the rules for kw.
+// zero: n = 0
+// one: n = 1
+// two: n % 100 = 2,22,42,62,82 or n % 1000 = 0 and n % 100000 = 1000..20000,40000,60000,80000 or n != 0 and n % 1000000 = 100000
+// few: n % 100 = 3,23,43,63,83
+// many: n != 1 and n % 100 = 1,21,41,61,81
+func cardinalKw(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 0:
+		return PluralZero
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && (op.I%100 == 2 || op.I%100 == 22 || op.I%100 == 42 || op.I%100 == 62 || op.I%100 == 82) ||
+		op.T == 0 && op.I%1000 == 0 && (op.I%100000 >= 1000 && op.I%100000 <= 20000 || op.I%100000 == 40000 || op.I%100000 == 60000 || op.I%100000 == 80000) ||
+		op.T == 0 && op.I != 0 && op.I%1000000 == 100000:
+		return PluralTwo
+	case op.T == 0 && (op.I%100 == 3 || op.I%100 == 23 || op.I%100 == 43 || op.I%100 == 63 || op.I%100 == 83):
+		return PluralFew
+	case op.T == 0 && op.I != 1 && (op.I%100 == 1 || op.I%100 == 21 || op.I%100 == 41 || op.I%100 == 61 || op.I%100 == 81):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalAr implements the rules for ar, ars.
+// zero: n = 0
+// one: n = 1
+// two: n = 2
+// few: n % 100 = 3..10
+// many: n % 100 = 11..99
+func cardinalAr(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 0:
+		return PluralZero
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I == 2:
+		return PluralTwo
+	case op.T == 0 && op.I%100 >= 3 && op.I%100 <= 10:
+		return PluralFew
+	case op.T == 0 && op.I%100 >= 11 && op.I%100 <= 99:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// cardinalCy implements the rules for cy.
+// zero: n = 0
+// one: n = 1
+// two: n = 2
+// few: n = 3
+// many: n = 6
+func cardinalCy(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 0:
+		return PluralZero
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I == 2:
+		return PluralTwo
+	case op.T == 0 && op.I == 3:
+		return PluralFew
+	case op.T == 0 && op.I == 6:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalAf implements the rules for af, am, an, ar, bg, bs, ce, cs, da, de, dsb, el, es, et, eu, fa, fi, fy, gl, gsw, he, hr, hsb, ia, id, in, is, iw, ja, km, kn, ko, ky, lt, lv, ml, mn, my, nb, nl, no, pa, pl, prg, ps, pt, ru, sd, sh, si, sk, sl, sr, sw, ta, te, th, tpi, tr, ur, uz, yue, zh, zu.
+func ordinalAf(op PluralOperands) PluralCategory {
+	return PluralOther
+}
+
+// ordinalSv implements the rules for sv.
+// one: n % 10 = 1,2 and n % 100 != 11,12
+func ordinalSv(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I%10 == 1 || op.I%10 == 2) && (op.I%100 != 11 && op.I%100 != 12):
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// ordinalBal implements the rules for bal, fil, fr, ga, hy, lo, mo, ms, ro, tl, vi.
+// one: n = 1
+func ordinalBal(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// ordinalHu implements the rules for hu.
+// one: n = 1,5
+func ordinalHu(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 1 || op.I == 5):
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// ordinalNe implements the rules for ne.
+// one: n = 1..4
+func ordinalNe(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I >= 1 && op.I <= 4:
+		return PluralOne
+	}
+	return PluralOther
+}
+
+// ordinalBe implements the rules for be.
+// few: n % 10 = 2,3 and n % 100 != 12,13
+func ordinalBe(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I%10 == 2 || op.I%10 == 3) && (op.I%100 != 12 && op.I%100 != 13):
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalUk implements the rules for uk.
+// few: n % 10 = 3 and n % 100 != 13
+func ordinalUk(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 3 && op.I%100 != 13:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalTk implements the rules for tk.
+// few: n % 10 = 6,9 or n = 10
+func ordinalTk(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I%10 == 6 || op.I%10 == 9) ||
+		op.T == 0 && op.I == 10:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalKk implements the rules for kk.
+// many: n % 10 = 6 or n % 10 = 9 or n % 10 = 0 and n != 0
+func ordinalKk(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 6 ||
+		op.T == 0 && op.I%10 == 9 ||
+		op.T == 0 && op.I%10 == 0 && op.I != 0:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalIt implements the rules for it, sc, scn.
+// many: n = 11,8,80,800
+func ordinalIt(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 11 || op.I == 8 || op.I == 80 || op.I == 800):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalLij implements the rules for lij.
+// many: n = 11,8,80..89,800..899
+func ordinalLij(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 11 || op.I == 8 || op.I >= 80 && op.I <= 89 || op.I >= 800 && op.I <= 899):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalKa implements the rules for ka.
+// one: i = 1
+// many: i = 0 or i % 100 = 2..20,40,60,80
+func ordinalKa(op PluralOperands) PluralCategory {
+	switch {
+	case op.I == 1:
+		return PluralOne
+	case op.I == 0 ||
+		(op.I%100 >= 2 && op.I%100 <= 20 || op.I%100 == 40 || op.I%100 == 60 || op.I%100 == 80):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalSq implements the rules for sq.
+// one: n = 1
+// many: n % 10 = 4 and n % 100 != 14
+func ordinalSq(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I%10 == 4 && op.I%100 != 14:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalKw implements the rules for kw.
+// one: n = 1..4 or n % 100 = 1..4,21..24,41..44,61..64,81..84
+// many: n = 5 or n % 100 = 5
+func ordinalKw(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I >= 1 && op.I <= 4 ||
+		op.T == 0 && (op.I%100 >= 1 && op.I%100 <= 4 || op.I%100 >= 21 && op.I%100 <= 24 || op.I%100 >= 41 && op.I%100 <= 44 || op.I%100 >= 61 && op.I%100 <= 64 || op.I%100 >= 81 && op.I%100 <= 84):
+		return PluralOne
+	case op.T == 0 && op.I == 5 ||
+		op.T == 0 && op.I%100 == 5:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalEn implements the rules for en.
+// one: n % 10 = 1 and n % 100 != 11
+// two: n % 10 = 2 and n % 100 != 12
+// few: n % 10 = 3 and n % 100 != 13
+func ordinalEn(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I%10 == 1 && op.I%100 != 11:
+		return PluralOne
+	case op.T == 0 && op.I%10 == 2 && op.I%100 != 12:
+		return PluralTwo
+	case op.T == 0 && op.I%10 == 3 && op.I%100 != 13:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalMr implements the rules for mr.
+// one: n = 1
+// two: n = 2,3
+// few: n = 4
+func ordinalMr(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && (op.I == 2 || op.I == 3):
+		return PluralTwo
+	case op.T == 0 && op.I == 4:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalGd implements the rules for gd.
+// one: n = 1,11
+// two: n = 2,12
+// few: n = 3,13
+func ordinalGd(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 1 || op.I == 11):
+		return PluralOne
+	case op.T == 0 && (op.I == 2 || op.I == 12):
+		return PluralTwo
+	case op.T == 0 && (op.I == 3 || op.I == 13):
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalCa implements the rules for ca.
+// one: n = 1,3
+// two: n = 2
+// few: n = 4
+func ordinalCa(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 1 || op.I == 3):
+		return PluralOne
+	case op.T == 0 && op.I == 2:
+		return PluralTwo
+	case op.T == 0 && op.I == 4:
+		return PluralFew
+	}
+	return PluralOther
+}
+
+// ordinalMk implements the rules for mk.
+// one: i % 10 = 1 and i % 100 != 11
+// two: i % 10 = 2 and i % 100 != 12
+// many: i % 10 = 7,8 and i % 100 != 17,18
+func ordinalMk(op PluralOperands) PluralCategory {
+	switch {
+	case op.I%10 == 1 && op.I%100 != 11:
+		return PluralOne
+	case op.I%10 == 2 && op.I%100 != 12:
+		return PluralTwo
+	case (op.I%10 == 7 || op.I%10 == 8) && (op.I%100 != 17 && op.I%100 != 18):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalAz implements the rules for az.
+// one: i % 10 = 1,2,5,7,8 or i % 100 = 20,50,70,80
+// few: i % 10 = 3,4 or i % 1000 = 100,200,300,400,500,600,700,800,900
+// many: i = 0 or i % 10 = 6 or i % 100 = 40,60,90
+func ordinalAz(op PluralOperands) PluralCategory {
+	switch {
+	case (op.I%10 == 1 || op.I%10 == 2 || op.I%10 == 5 || op.I%10 == 7 || op.I%10 == 8) ||
+		(op.I%100 == 20 || op.I%100 == 50 || op.I%100 == 70 || op.I%100 == 80):
+		return PluralOne
+	case (op.I%10 == 3 || op.I%10 == 4) ||
+		(op.I%1000 == 100 || op.I%1000 == 200 || op.I%1000 == 300 || op.I%1000 == 400 || op.I%1000 == 500 || op.I%1000 == 600 || op.I%1000 == 700 || op.I%1000 == 800 || op.I%1000 == 900):
+		return PluralFew
+	case op.I == 0 ||
+		op.I%10 == 6 ||
+		(op.I%100 == 40 || op.I%100 == 60 || op.I%100 == 90):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalGu implements the rules for gu, hi.
+// one: n = 1
+// two: n = 2,3
+// few: n = 4
+// many: n = 6
+func ordinalGu(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && (op.I == 2 || op.I == 3):
+		return PluralTwo
+	case op.T == 0 && op.I == 4:
+		return PluralFew
+	case op.T == 0 && op.I == 6:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalAs implements the rules for as, bn.
+// one: n = 1,5,7,8,9,10
+// two: n = 2,3
+// few: n = 4
+// many: n = 6
+func ordinalAs(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 1 || op.I == 5 || op.I == 7 || op.I == 8 || op.I == 9 || op.I == 10):
+		return PluralOne
+	case op.T == 0 && (op.I == 2 || op.I == 3):
+		return PluralTwo
+	case op.T == 0 && op.I == 4:
+		return PluralFew
+	case op.T == 0 && op.I == 6:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalOr implements the rules for or.
+// one: n = 1,5,7..9
+// two: n = 2,3
+// few: n = 4
+// many: n = 6
+func ordinalOr(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 1 || op.I == 5 || op.I >= 7 && op.I <= 9):
+		return PluralOne
+	case op.T == 0 && (op.I == 2 || op.I == 3):
+		return PluralTwo
+	case op.T == 0 && op.I == 4:
+		return PluralFew
+	case op.T == 0 && op.I == 6:
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// ordinalCy implements the rules for cy.
+// zero: n = 0,7,8,9
+// one: n = 1
+// two: n = 2
+// few: n = 3,4
+// many: n = 5,6
+func ordinalCy(op PluralOperands) PluralCategory {
+	switch {
+	case op.T == 0 && (op.I == 0 || op.I == 7 || op.I == 8 || op.I == 9):
+		return PluralZero
+	case op.T == 0 && op.I == 1:
+		return PluralOne
+	case op.T == 0 && op.I == 2:
+		return PluralTwo
+	case op.T == 0 && (op.I == 3 || op.I == 4):
+		return PluralFew
+	case op.T == 0 && (op.I == 5 || op.I == 6):
+		return PluralMany
+	}
+	return PluralOther
+}
+
+// rangesId holds the plural ranges for id, ja, km, ko, lo, ms, my, th, vi, yue, zh.
+var rangesId = map[pluralRange]PluralCategory{
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesAm holds the plural ranges for am, bn, fr, gu, hi, hy, kn, mr, pa, zu.
+var rangesAm = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralOne,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesFa holds the plural ranges for fa.
+var rangesFa = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralOne,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOther,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesKa holds the plural ranges for ka.
+var rangesKa = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOther}:   PluralOne,
+	{PluralOther, PluralOne}:   PluralOther,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesAz holds the plural ranges for az, de, el, gl, hu, it, kk, ky, ml, mn, ne, nl, pt, sq, sw, ta, te, tr, ug, uz.
+var rangesAz = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesAf holds the plural ranges for af, bg, ca, en, es, et, eu, fi, nb, ur.
+var rangesAf = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOther,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesDa holds the plural ranges for da, fil, is, sv.
+var rangesDa = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralOne,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesSi holds the plural ranges for si.
+var rangesSi = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralOne,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOther,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesMk holds the plural ranges for mk.
+var rangesMk = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralOther,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOther,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesLv holds the plural ranges for lv.
+var rangesLv = map[pluralRange]PluralCategory{
+	{PluralZero, PluralZero}:   PluralOther,
+	{PluralZero, PluralOne}:    PluralOne,
+	{PluralZero, PluralOther}:  PluralOther,
+	{PluralOne, PluralZero}:    PluralOther,
+	{PluralOne, PluralOne}:     PluralOne,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralOther, PluralZero}:  PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesHe holds the plural ranges for he.
+var rangesHe = map[pluralRange]PluralCategory{
+	{PluralOne, PluralTwo}:     PluralOther,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralTwo, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOther,
+	{PluralOther, PluralTwo}:   PluralOther,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesRo holds the plural ranges for ro.
+var rangesRo = map[pluralRange]PluralCategory{
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralFew, PluralOne}:     PluralFew,
+	{PluralFew, PluralFew}:     PluralFew,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesBs holds the plural ranges for bs, hr, sr.
+var rangesBs = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralOne,
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralFew, PluralOne}:     PluralOne,
+	{PluralFew, PluralFew}:     PluralFew,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesSl holds the plural ranges for sl.
+var rangesSl = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralFew,
+	{PluralOne, PluralTwo}:     PluralTwo,
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralTwo, PluralOne}:     PluralFew,
+	{PluralTwo, PluralTwo}:     PluralTwo,
+	{PluralTwo, PluralFew}:     PluralFew,
+	{PluralTwo, PluralOther}:   PluralOther,
+	{PluralFew, PluralOne}:     PluralFew,
+	{PluralFew, PluralTwo}:     PluralTwo,
+	{PluralFew, PluralFew}:     PluralFew,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralOther, PluralOne}:   PluralFew,
+	{PluralOther, PluralTwo}:   PluralTwo,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesCs holds the plural ranges for cs, pl, sk.
+var rangesCs = map[pluralRange]PluralCategory{
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralMany}:    PluralMany,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralFew, PluralFew}:     PluralFew,
+	{PluralFew, PluralMany}:    PluralMany,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralMany, PluralOne}:    PluralOne,
+	{PluralMany, PluralFew}:    PluralFew,
+	{PluralMany, PluralMany}:   PluralMany,
+	{PluralMany, PluralOther}:  PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralMany}:  PluralMany,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesBe holds the plural ranges for be, lt, ru, uk.
+var rangesBe = map[pluralRange]PluralCategory{
+	{PluralOne, PluralOne}:     PluralOne,
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralMany}:    PluralMany,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralFew, PluralOne}:     PluralOne,
+	{PluralFew, PluralFew}:     PluralFew,
+	{PluralFew, PluralMany}:    PluralMany,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralMany, PluralOne}:    PluralOne,
+	{PluralMany, PluralFew}:    PluralFew,
+	{PluralMany, PluralMany}:   PluralMany,
+	{PluralMany, PluralOther}:  PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralMany}:  PluralMany,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesGa holds the plural ranges for ga.
+var rangesGa = map[pluralRange]PluralCategory{
+	{PluralOne, PluralTwo}:     PluralTwo,
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralMany}:    PluralMany,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralTwo, PluralFew}:     PluralFew,
+	{PluralTwo, PluralMany}:    PluralMany,
+	{PluralTwo, PluralOther}:   PluralOther,
+	{PluralFew, PluralFew}:     PluralFew,
+	{PluralFew, PluralMany}:    PluralMany,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralMany, PluralMany}:   PluralMany,
+	{PluralMany, PluralOther}:  PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralTwo}:   PluralTwo,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralMany}:  PluralMany,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesAr holds the plural ranges for ar.
+var rangesAr = map[pluralRange]PluralCategory{
+	{PluralZero, PluralOne}:    PluralZero,
+	{PluralZero, PluralTwo}:    PluralZero,
+	{PluralZero, PluralFew}:    PluralFew,
+	{PluralZero, PluralMany}:   PluralMany,
+	{PluralZero, PluralOther}:  PluralOther,
+	{PluralOne, PluralTwo}:     PluralOther,
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralMany}:    PluralMany,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralTwo, PluralFew}:     PluralFew,
+	{PluralTwo, PluralMany}:    PluralMany,
+	{PluralTwo, PluralOther}:   PluralOther,
+	{PluralFew, PluralFew}:     PluralFew,
+	{PluralFew, PluralMany}:    PluralMany,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralMany, PluralFew}:    PluralFew,
+	{PluralMany, PluralMany}:   PluralMany,
+	{PluralMany, PluralOther}:  PluralOther,
+	{PluralOther, PluralOne}:   PluralOther,
+	{PluralOther, PluralTwo}:   PluralOther,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralMany}:  PluralMany,
+	{PluralOther, PluralOther}: PluralOther,
+}
+
+// rangesCy holds the plural ranges for cy.
+var rangesCy = map[pluralRange]PluralCategory{
+	{PluralZero, PluralOne}:    PluralOne,
+	{PluralZero, PluralTwo}:    PluralTwo,
+	{PluralZero, PluralFew}:    PluralFew,
+	{PluralZero, PluralMany}:   PluralMany,
+	{PluralZero, PluralOther}:  PluralOther,
+	{PluralOne, PluralTwo}:     PluralTwo,
+	{PluralOne, PluralFew}:     PluralFew,
+	{PluralOne, PluralMany}:    PluralMany,
+	{PluralOne, PluralOther}:   PluralOther,
+	{PluralTwo, PluralFew}:     PluralFew,
+	{PluralTwo, PluralMany}:    PluralMany,
+	{PluralTwo, PluralOther}:   PluralOther,
+	{PluralFew, PluralMany}:    PluralMany,
+	{PluralFew, PluralOther}:   PluralOther,
+	{PluralMany, PluralOther}:  PluralOther,
+	{PluralOther, PluralOne}:   PluralOne,
+	{PluralOther, PluralTwo}:   PluralTwo,
+	{PluralOther, PluralFew}:   PluralFew,
+	{PluralOther, PluralMany}:  PluralMany,
+	{PluralOther, PluralOther}: PluralOther,
+}
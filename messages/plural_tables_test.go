@@ -0,0 +1,1255 @@
+// Code generated by genplurals from CLDR plurals.xml, ordinals.xml and pluralRanges.xml; DO NOT EDIT.
+
+package messages
+
+import (
+	"strconv"
+	"testing"
+)
+
+// pluralSamples lists CLDR sample values by locale group and category.
+type pluralSamples struct {
+	locales  []string
+	category PluralCategory
+	samples  []string
+}
+
+var cardinalSamples = []pluralSamples{
+	{
+		locales:  []string{"bm", "bo", "dz", "hnj", "id", "ig", "ii", "in", "ja", "jbo", "jv", "jw", "kde", "kea", "km", "ko", "lkt", "lo", "ms", "my", "nqo", "osa", "sah", "ses", "sg", "su", "th", "to", "tpi", "vi", "wo", "yo", "yue", "zh"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"am", "as", "bn", "doi", "fa", "gu", "hi", "kn", "pcm", "zu"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04"},
+	},
+	{
+		locales:  []string{"am", "as", "bn", "doi", "fa", "gu", "hi", "kn", "pcm", "zu"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ff", "hy", "kab"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5"},
+	},
+	{
+		locales:  []string{"ff", "hy", "kab"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ast", "de", "en", "et", "fi", "fy", "gl", "ia", "io", "ji", "lij", "nl", "sc", "sv", "sw", "ur", "yi"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"ast", "de", "en", "et", "fi", "fy", "gl", "ia", "io", "ji", "lij", "nl", "sc", "sv", "sw", "ur", "yi"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"si"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "0.0", "0.1", "1.0", "0.00", "0.01", "1.00", "0.000", "0.001", "1.000", "0.0000", "0.0001", "1.0000"},
+	},
+	{
+		locales:  []string{"si"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ak", "bho", "guw", "ln", "mg", "nso", "pa", "ti", "wa"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "0.0", "1.0", "0.00", "1.00", "0.000", "1.000", "0.0000", "1.0000"},
+	},
+	{
+		locales:  []string{"ak", "bho", "guw", "ln", "mg", "nso", "pa", "ti", "wa"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"tzm"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "0.0", "1.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "19.0", "20.0", "21.0", "22.0", "23.0", "24.0"},
+	},
+	{
+		locales:  []string{"tzm"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "100", "101", "102", "103", "104", "105", "106", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"af", "an", "asa", "az", "bal", "bem", "bez", "bg", "brx", "ce", "cgg", "chr", "ckb", "dv", "ee", "el", "eo", "eu", "fo", "fur", "gsw", "ha", "haw", "hu", "jgo", "jmc", "ka", "kaj", "kcg", "kk", "kkj", "kl", "ks", "ksb", "ku", "ky", "lb", "lg", "mas", "mgo", "ml", "mn", "mr", "nah", "nb", "nd", "ne", "nn", "nnh", "no", "nr", "ny", "nyn", "om", "or", "os", "pap", "ps", "rm", "rof", "rwk", "saq", "sd", "sdh", "seh", "sn", "so", "sq", "ss", "ssy", "st", "syr", "ta", "te", "teo", "tig", "tk", "tn", "tr", "ts", "ug", "uz", "ve", "vo", "vun", "wae", "xh", "xog"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"af", "an", "asa", "az", "bal", "bem", "bez", "bg", "brx", "ce", "cgg", "chr", "ckb", "dv", "ee", "el", "eo", "eu", "fo", "fur", "gsw", "ha", "haw", "hu", "jgo", "jmc", "ka", "kaj", "kcg", "kk", "kkj", "kl", "ks", "ksb", "ku", "ky", "lb", "lg", "mas", "mgo", "ml", "mn", "mr", "nah", "nb", "nd", "ne", "nn", "nnh", "no", "nr", "ny", "nyn", "om", "or", "os", "pap", "ps", "rm", "rof", "rwk", "saq", "sd", "sdh", "seh", "sn", "so", "sq", "ss", "ssy", "st", "syr", "ta", "te", "teo", "tig", "tk", "tn", "tr", "ts", "ug", "uz", "ve", "vo", "vun", "wae", "xh", "xog"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"da"},
+		category: PluralOne,
+		samples:  []string{"1", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"},
+	},
+	{
+		locales:  []string{"da"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"is"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "0.1", "1.0", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1"},
+	},
+	{
+		locales:  []string{"is"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000", "0.0", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"mk"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "0.1", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1"},
+	},
+	{
+		locales:  []string{"mk"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.0", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ceb", "fil", "tl"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "2", "3", "5", "7", "8", "10", "11", "12", "13", "15", "17", "18", "20", "21", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.5", "0.7", "0.8", "1.0", "1.1", "1.2", "1.3", "1.5", "1.7", "1.8", "2.0", "2.1", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ceb", "fil", "tl"},
+		category: PluralOther,
+		samples:  []string{"4", "6", "9", "14", "16", "19", "24", "26", "104", "1004", "0.4", "0.6", "0.9", "1.4", "1.6", "1.9", "2.4", "2.6", "10.4", "100.4", "1000.4"},
+	},
+	{
+		locales:  []string{"lv", "prg"},
+		category: PluralZero,
+		samples:  []string{"0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000", "0.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"lv", "prg"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "0.1", "1.0", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1"},
+	},
+	{
+		locales:  []string{"lv", "prg"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "22", "23", "24", "25", "26", "27", "28", "29", "102", "1002", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "10.2", "100.2", "1000.2"},
+	},
+	{
+		locales:  []string{"lag"},
+		category: PluralZero,
+		samples:  []string{"0", "0.0", "0.00", "0.000", "0.0000"},
+	},
+	{
+		locales:  []string{"lag"},
+		category: PluralOne,
+		samples:  []string{"1", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6"},
+	},
+	{
+		locales:  []string{"lag"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ksh"},
+		category: PluralZero,
+		samples:  []string{"0", "0.0", "0.00", "0.000", "0.0000"},
+	},
+	{
+		locales:  []string{"ksh"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"ksh"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"he", "iw"},
+		category: PluralOne,
+		samples:  []string{"1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "0.00", "0.01", "0.02", "0.03", "0.04", "0.05"},
+	},
+	{
+		locales:  []string{"he", "iw"},
+		category: PluralTwo,
+		samples:  []string{"2"},
+	},
+	{
+		locales:  []string{"he", "iw"},
+		category: PluralOther,
+		samples:  []string{"0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"iu", "naq", "sat", "se", "sma", "smi", "smj", "smn", "sms"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"iu", "naq", "sat", "se", "sma", "smi", "smj", "smn", "sms"},
+		category: PluralTwo,
+		samples:  []string{"2", "2.0", "2.00", "2.000", "2.0000"},
+	},
+	{
+		locales:  []string{"iu", "naq", "sat", "se", "sma", "smi", "smj", "smn", "sms"},
+		category: PluralOther,
+		samples:  []string{"0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"shi"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "0.00", "0.01", "0.02", "0.03", "0.04"},
+	},
+	{
+		locales:  []string{"shi"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "2.00", "3.00", "4.00", "5.00", "6.00", "7.00", "8.00"},
+	},
+	{
+		locales:  []string{"shi"},
+		category: PluralOther,
+		samples:  []string{"11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "100", "1000", "10000", "100000", "1000000", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "1.8", "1.9", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"mo", "ro"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"mo", "ro"},
+		category: PluralFew,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "101", "1001", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"mo", "ro"},
+		category: PluralOther,
+		samples:  []string{"20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"bs", "hr", "sh", "sr"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "0.1", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1"},
+	},
+	{
+		locales:  []string{"bs", "hr", "sh", "sr"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002", "0.2", "0.3", "0.4", "1.2", "1.3", "1.4", "2.2", "2.3", "2.4", "3.2", "3.3", "3.4", "4.2", "4.3", "4.4", "5.2", "10.2", "100.2", "1000.2"},
+	},
+	{
+		locales:  []string{"bs", "hr", "sh", "sr"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000", "0.0", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.5", "2.6", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"fr"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5"},
+	},
+	{
+		locales:  []string{"fr"},
+		category: PluralMany,
+		samples:  []string{"1000000", "1c6", "2c6", "3c6", "4c6", "5c6", "6c6", "1.0000001c6", "1.1c6", "2.0000001c6", "2.1c6", "3.0000001c6", "3.1c6"},
+	},
+	{
+		locales:  []string{"fr"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1c3", "2c3", "3c3", "4c3", "5c3", "6c3", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "1.0001c3", "1.1c3", "2.0001c3", "2.1c3", "3.0001c3", "3.1c3"},
+	},
+	{
+		locales:  []string{"pt"},
+		category: PluralOne,
+		samples:  []string{"0", "1", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5"},
+	},
+	{
+		locales:  []string{"pt"},
+		category: PluralMany,
+		samples:  []string{"1000000", "1c6", "2c6", "3c6", "4c6", "5c6", "6c6", "1.0000001c6", "1.1c6", "2.0000001c6", "2.1c6", "3.0000001c6", "3.1c6"},
+	},
+	{
+		locales:  []string{"pt"},
+		category: PluralOther,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1c3", "2c3", "3c3", "4c3", "5c3", "6c3", "2.0", "2.1", "2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "1.0001c3", "1.1c3", "2.0001c3", "2.1c3", "3.0001c3", "3.1c3"},
+	},
+	{
+		locales:  []string{"ca", "it", "lld", "pt-PT", "scn", "vec"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"ca", "it", "lld", "pt-PT", "scn", "vec"},
+		category: PluralMany,
+		samples:  []string{"1000000", "1c6", "2c6", "3c6", "4c6", "5c6", "6c6", "1.0000001c6", "1.1c6", "2.0000001c6", "2.1c6", "3.0000001c6", "3.1c6"},
+	},
+	{
+		locales:  []string{"ca", "it", "lld", "pt-PT", "scn", "vec"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1c3", "2c3", "3c3", "4c3", "5c3", "6c3", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "1.0001c3", "1.1c3", "2.0001c3", "2.1c3", "3.0001c3", "3.1c3"},
+	},
+	{
+		locales:  []string{"es"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"es"},
+		category: PluralMany,
+		samples:  []string{"1000000", "1c6", "2c6", "3c6", "4c6", "5c6", "6c6", "1.0000001c6", "1.1c6", "2.0000001c6", "2.1c6", "3.0000001c6", "3.1c6"},
+	},
+	{
+		locales:  []string{"es"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1c3", "2c3", "3c3", "4c3", "5c3", "6c3", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0", "1.0001c3", "1.1c3", "2.0001c3", "2.1c3", "3.0001c3", "3.1c3"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralOne,
+		samples:  []string{"1", "11", "1.0", "11.0", "1.00", "11.00", "1.000", "11.000", "1.0000"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralTwo,
+		samples:  []string{"2", "12", "2.0", "12.0", "2.00", "12.00", "2.000", "12.000", "2.0000"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralFew,
+		samples:  []string{"3", "4", "5", "6", "7", "8", "9", "10", "13", "14", "15", "16", "17", "18", "19", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "19.0", "3.00"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralOther,
+		samples:  []string{"0", "20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"sl"},
+		category: PluralOne,
+		samples:  []string{"1", "101", "201", "301", "401", "501", "601", "701", "1001"},
+	},
+	{
+		locales:  []string{"sl"},
+		category: PluralTwo,
+		samples:  []string{"2", "102", "202", "302", "402", "502", "602", "702", "1002"},
+	},
+	{
+		locales:  []string{"sl"},
+		category: PluralFew,
+		samples:  []string{"3", "4", "103", "104", "203", "204", "303", "304", "403", "404", "503", "504", "603", "604", "703", "704", "1003", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"sl"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"dsb", "hsb"},
+		category: PluralOne,
+		samples:  []string{"1", "101", "201", "301", "401", "501", "601", "701", "1001", "0.1", "1.1", "2.1", "3.1", "4.1", "5.1", "6.1", "7.1", "10.1", "100.1", "1000.1"},
+	},
+	{
+		locales:  []string{"dsb", "hsb"},
+		category: PluralTwo,
+		samples:  []string{"2", "102", "202", "302", "402", "502", "602", "702", "1002", "0.2", "1.2", "2.2", "3.2", "4.2", "5.2", "6.2", "7.2", "10.2", "100.2", "1000.2"},
+	},
+	{
+		locales:  []string{"dsb", "hsb"},
+		category: PluralFew,
+		samples:  []string{"3", "4", "103", "104", "203", "204", "303", "304", "403", "404", "503", "504", "603", "604", "703", "704", "1003", "0.3", "0.4", "1.3", "1.4", "2.3", "2.4", "3.3", "3.4", "4.3", "4.4", "5.3", "5.4", "6.3", "6.4", "7.3", "7.4", "10.3", "100.3", "1000.3"},
+	},
+	{
+		locales:  []string{"dsb", "hsb"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000", "0.0", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.5", "1.6", "1.7", "1.8", "1.9", "2.0", "2.5", "2.6", "2.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"cs", "sk"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"cs", "sk"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "4"},
+	},
+	{
+		locales:  []string{"cs", "sk"},
+		category: PluralMany,
+		samples:  []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"cs", "sk"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"pl"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"pl"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002"},
+	},
+	{
+		locales:  []string{"pl"},
+		category: PluralMany,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"pl"},
+		category: PluralOther,
+		samples:  []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"be"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "1.0", "21.0", "31.0", "41.0", "51.0", "61.0", "71.0", "81.0", "101.0", "1001.0"},
+	},
+	{
+		locales:  []string{"be"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002", "2.0", "3.0", "4.0", "22.0", "23.0", "24.0", "32.0", "33.0", "102.0", "1002.0"},
+	},
+	{
+		locales:  []string{"be"},
+		category: PluralMany,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000", "0.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "11.0", "12.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"be"},
+		category: PluralOther,
+		samples:  []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.1", "1000.1"},
+	},
+	{
+		locales:  []string{"lt"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001", "1.0", "21.0", "31.0", "41.0", "51.0", "61.0", "71.0", "81.0", "101.0", "1001.0"},
+	},
+	{
+		locales:  []string{"lt"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "4", "5", "6", "7", "8", "9", "22", "23", "24", "25", "26", "27", "28", "29", "102", "1002", "2.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "22.0", "102.0", "1002.0"},
+	},
+	{
+		locales:  []string{"lt"},
+		category: PluralMany,
+		samples:  []string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.1", "1000.1"},
+	},
+	{
+		locales:  []string{"lt"},
+		category: PluralOther,
+		samples:  []string{"0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "30", "40", "50", "60", "100", "1000", "10000", "100000", "1000000", "0.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ru", "uk"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001"},
+	},
+	{
+		locales:  []string{"ru", "uk"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "4", "22", "23", "24", "32", "33", "34", "42", "43", "44", "52", "53", "54", "62", "102", "1002"},
+	},
+	{
+		locales:  []string{"ru", "uk"},
+		category: PluralMany,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"ru", "uk"},
+		category: PluralOther,
+		samples:  []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"br"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "81", "101", "1001", "1.0", "21.0", "31.0", "41.0", "51.0", "61.0", "81.0", "101.0", "1001.0"},
+	},
+	{
+		locales:  []string{"br"},
+		category: PluralTwo,
+		samples:  []string{"2", "22", "32", "42", "52", "62", "82", "102", "1002", "2.0", "22.0", "32.0", "42.0", "52.0", "62.0", "82.0", "102.0", "1002.0"},
+	},
+	{
+		locales:  []string{"br"},
+		category: PluralFew,
+		samples:  []string{"3", "4", "9", "23", "24", "29", "33", "34", "39", "43", "44", "49", "103", "1003", "3.0", "4.0", "9.0", "23.0", "24.0", "29.0", "33.0", "34.0", "103.0", "1003.0"},
+	},
+	{
+		locales:  []string{"br"},
+		category: PluralMany,
+		samples:  []string{"1000000", "1000000.0", "1000000.00", "1000000.000", "1000000.0000"},
+	},
+	{
+		locales:  []string{"br"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.0", "100.0", "1000.0", "10000.0", "100000.0"},
+	},
+	{
+		locales:  []string{"ga"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"ga"},
+		category: PluralTwo,
+		samples:  []string{"2", "2.0", "2.00", "2.000", "2.0000"},
+	},
+	{
+		locales:  []string{"ga"},
+		category: PluralFew,
+		samples:  []string{"3", "4", "5", "6", "3.0", "4.0", "5.0", "6.0", "3.00", "4.00", "5.00", "6.00", "3.000", "4.000", "5.000", "6.000", "3.0000", "4.0000", "5.0000", "6.0000"},
+	},
+	{
+		locales:  []string{"ga"},
+		category: PluralMany,
+		samples:  []string{"7", "8", "9", "10", "7.0", "8.0", "9.0", "10.0", "7.00", "8.00", "9.00", "10.00", "7.000", "8.000", "9.000", "10.000", "7.0000", "8.0000", "9.0000", "10.0000"},
+	},
+	{
+		locales:  []string{"ga"},
+		category: PluralOther,
+		samples:  []string{"0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000", "0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"gv"},
+		category: PluralOne,
+		samples:  []string{"1", "11", "21", "31", "41", "51", "61", "71", "101", "1001"},
+	},
+	{
+		locales:  []string{"gv"},
+		category: PluralTwo,
+		samples:  []string{"2", "12", "22", "32", "42", "52", "62", "72", "102", "1002"},
+	},
+	{
+		locales:  []string{"gv"},
+		category: PluralFew,
+		samples:  []string{"0", "20", "40", "60", "80", "100", "120", "140", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"gv"},
+		category: PluralMany,
+		samples:  []string{"0.0", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0", "1.1", "1.2", "1.3", "1.4", "1.5", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"gv"},
+		category: PluralOther,
+		samples:  []string{"3", "4", "5", "6", "7", "8", "9", "10", "13", "14", "15", "16", "17", "18", "19", "23", "103", "1003"},
+	},
+	{
+		locales:  []string{"mt"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"mt"},
+		category: PluralTwo,
+		samples:  []string{"2", "2.0", "2.00", "2.000", "2.0000"},
+	},
+	{
+		locales:  []string{"mt"},
+		category: PluralFew,
+		samples:  []string{"0", "3", "4", "5", "6", "7", "8", "9", "10", "103", "104", "105", "106", "107", "108", "109", "1003", "0.0", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "103.0", "1003.0"},
+	},
+	{
+		locales:  []string{"mt"},
+		category: PluralMany,
+		samples:  []string{"11", "12", "13", "14", "15", "16", "17", "18", "19", "111", "112", "113", "114", "115", "116", "117", "1011", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0"},
+	},
+	{
+		locales:  []string{"mt"},
+		category: PluralOther,
+		samples:  []string{"20", "21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralZero,
+		samples:  []string{"0", "0.0", "0.00", "0.000", "0.0000"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralTwo,
+		samples:  []string{"2", "22", "42", "62", "82", "102", "122", "142", "1000", "10000", "100000", "2.0", "22.0", "42.0", "62.0", "82.0", "102.0", "122.0", "142.0", "1000.0", "10000.0", "100000.0"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralFew,
+		samples:  []string{"3", "23", "43", "63", "83", "103", "123", "143", "1003", "3.0", "23.0", "43.0", "63.0", "83.0", "103.0", "123.0", "143.0", "1003.0"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralMany,
+		samples:  []string{"21", "41", "61", "81", "101", "121", "141", "161", "1001", "21.0", "41.0", "61.0", "81.0", "101.0", "121.0", "141.0", "161.0", "1001.0"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralOther,
+		samples:  []string{"4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1004", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "19.0", "100.0", "1004.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"ar", "ars"},
+		category: PluralZero,
+		samples:  []string{"0", "0.0", "0.00", "0.000", "0.0000"},
+	},
+	{
+		locales:  []string{"ar", "ars"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"ar", "ars"},
+		category: PluralTwo,
+		samples:  []string{"2", "2.0", "2.00", "2.000", "2.0000"},
+	},
+	{
+		locales:  []string{"ar", "ars"},
+		category: PluralFew,
+		samples:  []string{"3", "4", "5", "6", "7", "8", "9", "10", "103", "104", "105", "106", "107", "108", "109", "110", "1003", "3.0", "4.0", "5.0", "6.0", "7.0", "8.0", "9.0", "10.0", "103.0", "1003.0"},
+	},
+	{
+		locales:  []string{"ar", "ars"},
+		category: PluralMany,
+		samples:  []string{"11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "26", "111", "1011", "11.0", "12.0", "13.0", "14.0", "15.0", "16.0", "17.0", "18.0", "111.0", "1011.0"},
+	},
+	{
+		locales:  []string{"ar", "ars"},
+		category: PluralOther,
+		samples:  []string{"100", "101", "102", "200", "201", "202", "300", "301", "302", "400", "401", "402", "500", "501", "502", "600", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.1", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralZero,
+		samples:  []string{"0", "0.0", "0.00", "0.000", "0.0000"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralOne,
+		samples:  []string{"1", "1.0", "1.00", "1.000", "1.0000"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralTwo,
+		samples:  []string{"2", "2.0", "2.00", "2.000", "2.0000"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralFew,
+		samples:  []string{"3", "3.0", "3.00", "3.000", "3.0000"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralMany,
+		samples:  []string{"6", "6.0", "6.00", "6.000", "6.0000"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralOther,
+		samples:  []string{"4", "5", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000", "0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.1", "1.2", "1.3", "1.4", "1.5", "1.6", "1.7", "10.0", "100.0", "1000.0", "10000.0", "100000.0", "1000000.0"},
+	},
+}
+
+var ordinalSamples = []pluralSamples{
+	{
+		locales:  []string{"af", "am", "an", "ar", "bg", "bs", "ce", "cs", "da", "de", "dsb", "el", "es", "et", "eu", "fa", "fi", "fy", "gl", "gsw", "he", "hr", "hsb", "ia", "id", "in", "is", "iw", "ja", "km", "kn", "ko", "ky", "lt", "lv", "ml", "mn", "my", "nb", "nl", "no", "pa", "pl", "prg", "ps", "pt", "ru", "sd", "sh", "si", "sk", "sl", "sr", "sw", "ta", "te", "th", "tpi", "tr", "ur", "uz", "yue", "zh", "zu"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"sv"},
+		category: PluralOne,
+		samples:  []string{"1", "2", "21", "22", "31", "32", "41", "42", "51", "52", "61", "62", "71", "72", "81", "82", "101", "1001"},
+	},
+	{
+		locales:  []string{"sv"},
+		category: PluralOther,
+		samples:  []string{"0", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"bal", "fil", "fr", "ga", "hy", "lo", "mo", "ms", "ro", "tl", "vi"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"bal", "fil", "fr", "ga", "hy", "lo", "mo", "ms", "ro", "tl", "vi"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"hu"},
+		category: PluralOne,
+		samples:  []string{"1", "5"},
+	},
+	{
+		locales:  []string{"hu"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "4", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"ne"},
+		category: PluralOne,
+		samples:  []string{"1", "2", "3", "4"},
+	},
+	{
+		locales:  []string{"ne"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"be"},
+		category: PluralFew,
+		samples:  []string{"2", "3", "22", "23", "32", "33", "42", "43", "52", "53", "62", "63", "72", "73", "82", "83", "102", "1002"},
+	},
+	{
+		locales:  []string{"be"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"uk"},
+		category: PluralFew,
+		samples:  []string{"3", "23", "33", "43", "53", "63", "73", "83", "103", "1003"},
+	},
+	{
+		locales:  []string{"uk"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "2", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"tk"},
+		category: PluralFew,
+		samples:  []string{"6", "9", "10", "16", "19", "26", "29", "36", "39", "106", "1006"},
+	},
+	{
+		locales:  []string{"tk"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "2", "3", "4", "5", "7", "8", "11", "12", "13", "14", "15", "17", "18", "20", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"kk"},
+		category: PluralMany,
+		samples:  []string{"6", "9", "10", "16", "19", "20", "26", "29", "30", "36", "39", "40", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"kk"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "2", "3", "4", "5", "7", "8", "11", "12", "13", "14", "15", "17", "18", "21", "101", "1001"},
+	},
+	{
+		locales:  []string{"it", "sc", "scn"},
+		category: PluralMany,
+		samples:  []string{"8", "11", "80", "800"},
+	},
+	{
+		locales:  []string{"it", "sc", "scn"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "2", "3", "4", "5", "6", "7", "9", "10", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"lij"},
+		category: PluralMany,
+		samples:  []string{"8", "11", "80", "81", "82", "83", "84", "85", "86", "87", "88", "89", "800", "801", "802", "803"},
+	},
+	{
+		locales:  []string{"lij"},
+		category: PluralOther,
+		samples:  []string{"0", "1", "2", "3", "4", "5", "6", "7", "9", "10", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"ka"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"ka"},
+		category: PluralMany,
+		samples:  []string{"0", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "102", "1002"},
+	},
+	{
+		locales:  []string{"ka"},
+		category: PluralOther,
+		samples:  []string{"21", "22", "23", "24", "25", "26", "27", "28", "29", "30", "31", "32", "33", "34", "35", "36", "101", "1001"},
+	},
+	{
+		locales:  []string{"sq"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"sq"},
+		category: PluralMany,
+		samples:  []string{"4", "24", "34", "44", "54", "64", "74", "84", "104", "1004"},
+	},
+	{
+		locales:  []string{"sq"},
+		category: PluralOther,
+		samples:  []string{"0", "2", "3", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralOne,
+		samples:  []string{"1", "2", "3", "4", "21", "22", "23", "24", "41", "42", "43", "44", "61", "62", "63", "64", "101", "1001"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralMany,
+		samples:  []string{"5", "105", "205", "305", "405", "505", "605", "705", "1005"},
+	},
+	{
+		locales:  []string{"kw"},
+		category: PluralOther,
+		samples:  []string{"0", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"en"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001"},
+	},
+	{
+		locales:  []string{"en"},
+		category: PluralTwo,
+		samples:  []string{"2", "22", "32", "42", "52", "62", "72", "82", "102", "1002"},
+	},
+	{
+		locales:  []string{"en"},
+		category: PluralFew,
+		samples:  []string{"3", "23", "33", "43", "53", "63", "73", "83", "103", "1003"},
+	},
+	{
+		locales:  []string{"en"},
+		category: PluralOther,
+		samples:  []string{"0", "4", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"mr"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"mr"},
+		category: PluralTwo,
+		samples:  []string{"2", "3"},
+	},
+	{
+		locales:  []string{"mr"},
+		category: PluralFew,
+		samples:  []string{"4"},
+	},
+	{
+		locales:  []string{"mr"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralOne,
+		samples:  []string{"1", "11"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralTwo,
+		samples:  []string{"2", "12"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralFew,
+		samples:  []string{"3", "13"},
+	},
+	{
+		locales:  []string{"gd"},
+		category: PluralOther,
+		samples:  []string{"0", "4", "5", "6", "7", "8", "9", "10", "14", "15", "16", "17", "18", "19", "20", "21", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"ca"},
+		category: PluralOne,
+		samples:  []string{"1", "3"},
+	},
+	{
+		locales:  []string{"ca"},
+		category: PluralTwo,
+		samples:  []string{"2"},
+	},
+	{
+		locales:  []string{"ca"},
+		category: PluralFew,
+		samples:  []string{"4"},
+	},
+	{
+		locales:  []string{"ca"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "6", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"mk"},
+		category: PluralOne,
+		samples:  []string{"1", "21", "31", "41", "51", "61", "71", "81", "101", "1001"},
+	},
+	{
+		locales:  []string{"mk"},
+		category: PluralTwo,
+		samples:  []string{"2", "22", "32", "42", "52", "62", "72", "82", "102", "1002"},
+	},
+	{
+		locales:  []string{"mk"},
+		category: PluralMany,
+		samples:  []string{"7", "8", "27", "28", "37", "38", "47", "48", "57", "58", "67", "68", "77", "78", "87", "88", "107", "1007"},
+	},
+	{
+		locales:  []string{"mk"},
+		category: PluralOther,
+		samples:  []string{"0", "3", "4", "5", "6", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"az"},
+		category: PluralOne,
+		samples:  []string{"1", "2", "5", "7", "8", "11", "12", "15", "17", "18", "20", "21", "22", "25", "101", "1001"},
+	},
+	{
+		locales:  []string{"az"},
+		category: PluralFew,
+		samples:  []string{"3", "4", "13", "14", "23", "24", "33", "34", "43", "44", "53", "54", "63", "64", "73", "74", "100", "1003"},
+	},
+	{
+		locales:  []string{"az"},
+		category: PluralMany,
+		samples:  []string{"0", "6", "16", "26", "36", "40", "46", "56", "106", "1006"},
+	},
+	{
+		locales:  []string{"az"},
+		category: PluralOther,
+		samples:  []string{"9", "10", "19", "29", "30", "39", "49", "59", "69", "79", "109", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"gu", "hi"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"gu", "hi"},
+		category: PluralTwo,
+		samples:  []string{"2", "3"},
+	},
+	{
+		locales:  []string{"gu", "hi"},
+		category: PluralFew,
+		samples:  []string{"4"},
+	},
+	{
+		locales:  []string{"gu", "hi"},
+		category: PluralMany,
+		samples:  []string{"6"},
+	},
+	{
+		locales:  []string{"gu", "hi"},
+		category: PluralOther,
+		samples:  []string{"0", "5", "7", "8", "9", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"as", "bn"},
+		category: PluralOne,
+		samples:  []string{"1", "5", "7", "8", "9", "10"},
+	},
+	{
+		locales:  []string{"as", "bn"},
+		category: PluralTwo,
+		samples:  []string{"2", "3"},
+	},
+	{
+		locales:  []string{"as", "bn"},
+		category: PluralFew,
+		samples:  []string{"4"},
+	},
+	{
+		locales:  []string{"as", "bn"},
+		category: PluralMany,
+		samples:  []string{"6"},
+	},
+	{
+		locales:  []string{"as", "bn"},
+		category: PluralOther,
+		samples:  []string{"0", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"or"},
+		category: PluralOne,
+		samples:  []string{"1", "5", "7", "8", "9"},
+	},
+	{
+		locales:  []string{"or"},
+		category: PluralTwo,
+		samples:  []string{"2", "3"},
+	},
+	{
+		locales:  []string{"or"},
+		category: PluralFew,
+		samples:  []string{"4"},
+	},
+	{
+		locales:  []string{"or"},
+		category: PluralMany,
+		samples:  []string{"6"},
+	},
+	{
+		locales:  []string{"or"},
+		category: PluralOther,
+		samples:  []string{"0", "10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "100", "1000", "10000", "100000", "1000000"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralZero,
+		samples:  []string{"0", "7", "8", "9"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralOne,
+		samples:  []string{"1"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralTwo,
+		samples:  []string{"2"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralFew,
+		samples:  []string{"3", "4"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralMany,
+		samples:  []string{"5", "6"},
+	},
+	{
+		locales:  []string{"cy"},
+		category: PluralOther,
+		samples:  []string{"10", "11", "12", "13", "14", "15", "16", "17", "18", "19", "20", "21", "22", "23", "24", "25", "100", "1000", "10000", "100000", "1000000"},
+	},
+}
+
+var pluralRangeSamples = []struct {
+	locales            []string
+	start, end, result PluralCategory
+}{
+	{[]string{"id", "ja", "km", "ko", "lo", "ms", "my", "th", "vi", "yue", "zh"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"am", "bn", "fr", "gu", "hi", "hy", "kn", "mr", "pa", "zu"}, PluralOne, PluralOne, PluralOne},
+	{[]string{"am", "bn", "fr", "gu", "hi", "hy", "kn", "mr", "pa", "zu"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"am", "bn", "fr", "gu", "hi", "hy", "kn", "mr", "pa", "zu"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"fa"}, PluralOne, PluralOne, PluralOne},
+	{[]string{"fa"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"fa"}, PluralOther, PluralOne, PluralOther},
+	{[]string{"fa"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"ka"}, PluralOne, PluralOther, PluralOne},
+	{[]string{"ka"}, PluralOther, PluralOne, PluralOther},
+	{[]string{"ka"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"az", "de", "el", "gl", "hu", "it", "kk", "ky", "ml", "mn", "ne", "nl", "pt", "sq", "sw", "ta", "te", "tr", "ug", "uz"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"az", "de", "el", "gl", "hu", "it", "kk", "ky", "ml", "mn", "ne", "nl", "pt", "sq", "sw", "ta", "te", "tr", "ug", "uz"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"az", "de", "el", "gl", "hu", "it", "kk", "ky", "ml", "mn", "ne", "nl", "pt", "sq", "sw", "ta", "te", "tr", "ug", "uz"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"af", "bg", "ca", "en", "es", "et", "eu", "fi", "nb", "ur"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"af", "bg", "ca", "en", "es", "et", "eu", "fi", "nb", "ur"}, PluralOther, PluralOne, PluralOther},
+	{[]string{"af", "bg", "ca", "en", "es", "et", "eu", "fi", "nb", "ur"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"da", "fil", "is", "sv"}, PluralOne, PluralOne, PluralOne},
+	{[]string{"da", "fil", "is", "sv"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"da", "fil", "is", "sv"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"da", "fil", "is", "sv"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"si"}, PluralOne, PluralOne, PluralOne},
+	{[]string{"si"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"si"}, PluralOther, PluralOne, PluralOther},
+	{[]string{"si"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"mk"}, PluralOne, PluralOne, PluralOther},
+	{[]string{"mk"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"mk"}, PluralOther, PluralOne, PluralOther},
+	{[]string{"mk"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"lv"}, PluralZero, PluralZero, PluralOther},
+	{[]string{"lv"}, PluralZero, PluralOne, PluralOne},
+	{[]string{"lv"}, PluralZero, PluralOther, PluralOther},
+	{[]string{"lv"}, PluralOne, PluralZero, PluralOther},
+	{[]string{"lv"}, PluralOne, PluralOne, PluralOne},
+	{[]string{"lv"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"lv"}, PluralOther, PluralZero, PluralOther},
+	{[]string{"lv"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"lv"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"he"}, PluralOne, PluralTwo, PluralOther},
+	{[]string{"he"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"he"}, PluralTwo, PluralOther, PluralOther},
+	{[]string{"he"}, PluralOther, PluralOne, PluralOther},
+	{[]string{"he"}, PluralOther, PluralTwo, PluralOther},
+	{[]string{"he"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"ro"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"ro"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"ro"}, PluralFew, PluralOne, PluralFew},
+	{[]string{"ro"}, PluralFew, PluralFew, PluralFew},
+	{[]string{"ro"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"ro"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"ro"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"bs", "hr", "sr"}, PluralOne, PluralOne, PluralOne},
+	{[]string{"bs", "hr", "sr"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"bs", "hr", "sr"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"bs", "hr", "sr"}, PluralFew, PluralOne, PluralOne},
+	{[]string{"bs", "hr", "sr"}, PluralFew, PluralFew, PluralFew},
+	{[]string{"bs", "hr", "sr"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"bs", "hr", "sr"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"bs", "hr", "sr"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"bs", "hr", "sr"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"sl"}, PluralOne, PluralOne, PluralFew},
+	{[]string{"sl"}, PluralOne, PluralTwo, PluralTwo},
+	{[]string{"sl"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"sl"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"sl"}, PluralTwo, PluralOne, PluralFew},
+	{[]string{"sl"}, PluralTwo, PluralTwo, PluralTwo},
+	{[]string{"sl"}, PluralTwo, PluralFew, PluralFew},
+	{[]string{"sl"}, PluralTwo, PluralOther, PluralOther},
+	{[]string{"sl"}, PluralFew, PluralOne, PluralFew},
+	{[]string{"sl"}, PluralFew, PluralTwo, PluralTwo},
+	{[]string{"sl"}, PluralFew, PluralFew, PluralFew},
+	{[]string{"sl"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"sl"}, PluralOther, PluralOne, PluralFew},
+	{[]string{"sl"}, PluralOther, PluralTwo, PluralTwo},
+	{[]string{"sl"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"sl"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"cs", "pl", "sk"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"cs", "pl", "sk"}, PluralOne, PluralMany, PluralMany},
+	{[]string{"cs", "pl", "sk"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"cs", "pl", "sk"}, PluralFew, PluralFew, PluralFew},
+	{[]string{"cs", "pl", "sk"}, PluralFew, PluralMany, PluralMany},
+	{[]string{"cs", "pl", "sk"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"cs", "pl", "sk"}, PluralMany, PluralOne, PluralOne},
+	{[]string{"cs", "pl", "sk"}, PluralMany, PluralFew, PluralFew},
+	{[]string{"cs", "pl", "sk"}, PluralMany, PluralMany, PluralMany},
+	{[]string{"cs", "pl", "sk"}, PluralMany, PluralOther, PluralOther},
+	{[]string{"cs", "pl", "sk"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"cs", "pl", "sk"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"cs", "pl", "sk"}, PluralOther, PluralMany, PluralMany},
+	{[]string{"cs", "pl", "sk"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOne, PluralOne, PluralOne},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOne, PluralMany, PluralMany},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"be", "lt", "ru", "uk"}, PluralFew, PluralOne, PluralOne},
+	{[]string{"be", "lt", "ru", "uk"}, PluralFew, PluralFew, PluralFew},
+	{[]string{"be", "lt", "ru", "uk"}, PluralFew, PluralMany, PluralMany},
+	{[]string{"be", "lt", "ru", "uk"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"be", "lt", "ru", "uk"}, PluralMany, PluralOne, PluralOne},
+	{[]string{"be", "lt", "ru", "uk"}, PluralMany, PluralFew, PluralFew},
+	{[]string{"be", "lt", "ru", "uk"}, PluralMany, PluralMany, PluralMany},
+	{[]string{"be", "lt", "ru", "uk"}, PluralMany, PluralOther, PluralOther},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOther, PluralMany, PluralMany},
+	{[]string{"be", "lt", "ru", "uk"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"ga"}, PluralOne, PluralTwo, PluralTwo},
+	{[]string{"ga"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"ga"}, PluralOne, PluralMany, PluralMany},
+	{[]string{"ga"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"ga"}, PluralTwo, PluralFew, PluralFew},
+	{[]string{"ga"}, PluralTwo, PluralMany, PluralMany},
+	{[]string{"ga"}, PluralTwo, PluralOther, PluralOther},
+	{[]string{"ga"}, PluralFew, PluralFew, PluralFew},
+	{[]string{"ga"}, PluralFew, PluralMany, PluralMany},
+	{[]string{"ga"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"ga"}, PluralMany, PluralMany, PluralMany},
+	{[]string{"ga"}, PluralMany, PluralOther, PluralOther},
+	{[]string{"ga"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"ga"}, PluralOther, PluralTwo, PluralTwo},
+	{[]string{"ga"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"ga"}, PluralOther, PluralMany, PluralMany},
+	{[]string{"ga"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"ar"}, PluralZero, PluralOne, PluralZero},
+	{[]string{"ar"}, PluralZero, PluralTwo, PluralZero},
+	{[]string{"ar"}, PluralZero, PluralFew, PluralFew},
+	{[]string{"ar"}, PluralZero, PluralMany, PluralMany},
+	{[]string{"ar"}, PluralZero, PluralOther, PluralOther},
+	{[]string{"ar"}, PluralOne, PluralTwo, PluralOther},
+	{[]string{"ar"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"ar"}, PluralOne, PluralMany, PluralMany},
+	{[]string{"ar"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"ar"}, PluralTwo, PluralFew, PluralFew},
+	{[]string{"ar"}, PluralTwo, PluralMany, PluralMany},
+	{[]string{"ar"}, PluralTwo, PluralOther, PluralOther},
+	{[]string{"ar"}, PluralFew, PluralFew, PluralFew},
+	{[]string{"ar"}, PluralFew, PluralMany, PluralMany},
+	{[]string{"ar"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"ar"}, PluralMany, PluralFew, PluralFew},
+	{[]string{"ar"}, PluralMany, PluralMany, PluralMany},
+	{[]string{"ar"}, PluralMany, PluralOther, PluralOther},
+	{[]string{"ar"}, PluralOther, PluralOne, PluralOther},
+	{[]string{"ar"}, PluralOther, PluralTwo, PluralOther},
+	{[]string{"ar"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"ar"}, PluralOther, PluralMany, PluralMany},
+	{[]string{"ar"}, PluralOther, PluralOther, PluralOther},
+	{[]string{"cy"}, PluralZero, PluralOne, PluralOne},
+	{[]string{"cy"}, PluralZero, PluralTwo, PluralTwo},
+	{[]string{"cy"}, PluralZero, PluralFew, PluralFew},
+	{[]string{"cy"}, PluralZero, PluralMany, PluralMany},
+	{[]string{"cy"}, PluralZero, PluralOther, PluralOther},
+	{[]string{"cy"}, PluralOne, PluralTwo, PluralTwo},
+	{[]string{"cy"}, PluralOne, PluralFew, PluralFew},
+	{[]string{"cy"}, PluralOne, PluralMany, PluralMany},
+	{[]string{"cy"}, PluralOne, PluralOther, PluralOther},
+	{[]string{"cy"}, PluralTwo, PluralFew, PluralFew},
+	{[]string{"cy"}, PluralTwo, PluralMany, PluralMany},
+	{[]string{"cy"}, PluralTwo, PluralOther, PluralOther},
+	{[]string{"cy"}, PluralFew, PluralMany, PluralMany},
+	{[]string{"cy"}, PluralFew, PluralOther, PluralOther},
+	{[]string{"cy"}, PluralMany, PluralOther, PluralOther},
+	{[]string{"cy"}, PluralOther, PluralOne, PluralOne},
+	{[]string{"cy"}, PluralOther, PluralTwo, PluralTwo},
+	{[]string{"cy"}, PluralOther, PluralFew, PluralFew},
+	{[]string{"cy"}, PluralOther, PluralMany, PluralMany},
+	{[]string{"cy"}, PluralOther, PluralOther, PluralOther},
+}
+
+func TestCardinalRuleSamples(t *testing.T) {
+	for _, tt := range cardinalSamples {
+		for _, loc := range tt.locales {
+			for _, s := range tt.samples {
+				op, err := ParsePluralOperands(s)
+				if err != nil {
+					t.Fatalf("ParsePluralOperands(%q) error: %v", s, err)
+				}
+				if got := GetPluralCategoryOperands(loc, op); got != tt.category {
+					t.Errorf("GetPluralCategoryOperands(%q, %s) = %q, want %q", loc, s, got, tt.category)
+				}
+			}
+		}
+	}
+}
+
+func TestOrdinalRuleSamples(t *testing.T) {
+	for _, tt := range ordinalSamples {
+		for _, loc := range tt.locales {
+			for _, s := range tt.samples {
+				n, err := strconv.Atoi(s)
+				if err != nil {
+					t.Fatalf("strconv.Atoi(%q) error: %v", s, err)
+				}
+				if got := GetOrdinalCategory(loc, n); got != tt.category {
+					t.Errorf("GetOrdinalCategory(%q, %d) = %q, want %q", loc, n, got, tt.category)
+				}
+			}
+		}
+	}
+}
+
+func TestPluralRangeRules(t *testing.T) {
+	for _, tt := range pluralRangeSamples {
+		for _, loc := range tt.locales {
+			if got := GetPluralRangeCategory(loc, tt.start, tt.end); got != tt.result {
+				t.Errorf("GetPluralRangeCategory(%q, %q, %q) = %q, want %q", loc, tt.start, tt.end, got, tt.result)
+			}
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package messages
+
+// pluralRange is a pair of start and end plural categories.
+type pluralRange struct {
+	start, end PluralCategory
+}
+
+// GetPluralRangeCategory returns the CLDR plural category for a range of
+// numbers, such as "1–3 days", given the categories of its start and end.
+// The category usually follows the end of the range, but not always: in
+// Latvian "0–1" takes the "one" form, and in Macedonian every range is
+// "other". For locales and pairs without CLDR data, the end category is
+// returned.
+func GetPluralRangeCategory(loc string, start, end PluralCategory) PluralCategory {
+	return lookupPluralRules(loc).rangeCategory(start, end)
+}
+
+// GetPluralRangeCategoryCounts returns the CLDR plural category for the
+// range of integers from start to end in a locale.
+func GetPluralRangeCategoryCounts(loc string, start, end int) PluralCategory {
+	return lookupPluralRules(loc).rangeCategoryCounts(start, end)
+}
+
+// rangeCategoryCounts returns the plural category of the range of integers
+// from start to end.
+func (p pluralRules) rangeCategoryCounts(start, end int) PluralCategory {
+	return p.rangeCategory(p.cardinalCategory(IntOperands(int64(start))), p.cardinalCategory(IntOperands(int64(end))))
+}
@@ -0,0 +1,92 @@
+package messages
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetPluralRangeCategoryCounts(t *testing.T) {
+	tests := []struct {
+		locale     string
+		start, end int
+		expected   PluralCategory
+	}{
+		{"en", 1, 3, PluralOther},
+		{"en", 0, 1, PluralOther},
+		{"fr", 0, 1, PluralOne},
+		{"fr", 1, 2, PluralOther},
+		{"de", 0, 1, PluralOne},
+		{"lv", 0, 1, PluralOne},
+		{"lv", 10, 20, PluralOther},
+		{"mk", 1, 21, PluralOther},
+		{"ru", 1, 2, PluralFew},
+		{"ru", 1, 5, PluralMany},
+		{"ru", 5, 21, PluralOne},
+		{"pl", 2, 5, PluralMany},
+		{"ar", 0, 1, PluralZero},
+		{"ja", 1, 3, PluralOther},
+
+		// Locales without range data use the end category
+		{"xx", 0, 1, PluralOne},
+		{"invalid locale!", 0, 1, PluralOne},
+	}
+
+	for _, tt := range tests {
+		got := GetPluralRangeCategoryCounts(tt.locale, tt.start, tt.end)
+		if got != tt.expected {
+			t.Errorf("GetPluralRangeCategoryCounts(%q, %d, %d) = %q, expected %q", tt.locale, tt.start, tt.end, got, tt.expected)
+		}
+	}
+}
+
+func TestLocalizer_Trange(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "days", "translation": {
+			"one": "{{.Start}}–{{.End}} day",
+			"other": "{{.Start}}–{{.End}} days"
+		}},
+		{"id": "range", "translation": "{{.Start}} to {{.End}}"}
+	]}`))
+	_ = b.AddLocale("ru", []byte(`{"messages": [
+		{"id": "days", "translation": {
+			"one": "{{.Start}}–{{.End}} день",
+			"few": "{{.Start}}–{{.End}} дня",
+			"many": "{{.Start}}–{{.End}} дней",
+			"other": "{{.Start}}–{{.End}} дня"
+		}}
+	]}`))
+
+	tests := []struct {
+		locale     string
+		start, end int
+		expected   string
+	}{
+		{"en", 1, 3, "1–3 days"},
+		{"ru", 1, 2, "1–2 дня"},
+		{"ru", 1, 5, "1–5 дней"},
+		{"ru", 5, 21, "5–21 день"},
+	}
+
+	for _, tt := range tests {
+		l := b.Localizer(tt.locale)
+		if got := l.Trange("days", tt.start, tt.end); got != tt.expected {
+			t.Errorf("Trange(days, %d, %d) in %s = %q, expected %q", tt.start, tt.end, tt.locale, got, tt.expected)
+		}
+	}
+
+	l := b.Localizer("en")
+	if got := l.Trange("range", 2, 4); got != "2 to 4" {
+		t.Errorf("Trange(range) = %q, expected %q", got, "2 to 4")
+	}
+
+	got, err := l.LocalizeRange("days", 1, 2, nil)
+	if err != nil || got != "1–2 days" {
+		t.Errorf("LocalizeRange(days) = %q, %v", got, err)
+	}
+
+	_, err = l.LocalizeRange("missing", 1, 2, nil)
+	if !errors.Is(err, ErrMessageNotFound) {
+		t.Errorf("LocalizeRange(missing) error = %v, expected ErrMessageNotFound", err)
+	}
+}
@@ -0,0 +1,266 @@
+package messages
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval is the default interval between Reloader polls.
+const DefaultPollInterval = 2 * time.Second
+
+// ReloadEvent reports the outcome of reloading one locale.
+type ReloadEvent struct {
+	Locale string   // normalized locale, empty if it could not be inferred
+	Files  []string // files that make up the locale, sorted
+	Err    error    // nil on success; on failure the last good version is kept
+}
+
+// ReloaderOption configures a Reloader.
+type ReloaderOption func(*Reloader)
+
+// WithPollInterval sets how often the Reloader checks for changes.
+func WithPollInterval(d time.Duration) ReloaderOption {
+	return func(r *Reloader) {
+		r.interval = d
+	}
+}
+
+// WithReloadCallback sets a function called for every reloaded locale,
+// including failed reloads. It is called synchronously from the polling
+// goroutine; send to a channel from it to process events elsewhere.
+func WithReloadCallback(fn func(ReloadEvent)) ReloaderOption {
+	return func(r *Reloader) {
+		r.onReload = fn
+	}
+}
+
+// WithReloadLocaleFunc overrides how a file's locale is inferred from its
+// path. The default is InferLocale.
+func WithReloadLocaleFunc(fn func(name string) (string, error)) ReloaderOption {
+	return func(r *Reloader) {
+		r.localeOf = fn
+	}
+}
+
+// Reloader polls messages files and atomically replaces changed locales in
+// a Bundle, so translations can be updated without restarting. Use
+// os.DirFS to watch a directory on disk. Changes are detected by
+// modification time and size, then confirmed with a content hash.
+//
+// All files of a changed locale are re-read together and replace the
+// locale in one update. If any of them fails to parse, the locale keeps its
+// last good version until the file changes again. A file that cannot be
+// read is reported but not treated as removed.
+type Reloader struct {
+	bundle   *Bundle
+	fsys     fs.FS
+	pattern  string
+	interval time.Duration
+	onReload func(ReloadEvent)
+	localeOf func(name string) (string, error)
+
+	mu    sync.Mutex
+	files map[string]fileState
+}
+
+// fileState records what was last seen of a file.
+type fileState struct {
+	modTime time.Time
+	size    int64
+	hash    [sha256.Size]byte
+	locale  string
+	invalid bool // locale could not be determined
+}
+
+// NewReloader creates a Reloader for files in fsys matching the fs.Glob
+// pattern. Call Run to start polling, or Check to poll once.
+func NewReloader(b *Bundle, fsys fs.FS, pattern string, opts ...ReloaderOption) *Reloader {
+	r := &Reloader{
+		bundle:   b,
+		fsys:     fsys,
+		pattern:  pattern,
+		interval: DefaultPollInterval,
+		localeOf: InferLocale,
+		files:    make(map[string]fileState),
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// Run checks for changes immediately and then at every poll interval until
+// ctx is canceled. It returns ctx.Err().
+func (r *Reloader) Run(ctx context.Context) error {
+	r.Check()
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			r.Check()
+		}
+	}
+}
+
+// Check polls the files once, reloads changed locales and returns one
+// event per reloaded locale. The first call loads every matching file.
+func (r *Reloader) Check() []ReloadEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names, err := fs.Glob(r.fsys, r.pattern)
+	if err != nil {
+		return r.emit([]ReloadEvent{{Err: fmt.Errorf("reloading messages: %w", err)}})
+	}
+
+	var events []ReloadEvent
+	changed := make(map[string]bool)
+	current := make(map[string]fileState, len(names))
+
+	for _, name := range names {
+		st, ok, err := r.stat(name)
+		if err != nil {
+			events = append(events, ReloadEvent{Files: []string{name}, Err: fmt.Errorf("%s: %w", name, err)})
+			if prev, seen := r.files[name]; seen && !ok {
+				// Keep the last good version of a file that cannot be read
+				current[name] = prev
+				continue
+			}
+		}
+		if !ok {
+			continue
+		}
+		current[name] = st
+		if st.invalid {
+			continue
+		}
+		if prev, seen := r.files[name]; !seen || prev.hash != st.hash || prev.locale != st.locale {
+			changed[st.locale] = true
+		}
+	}
+	for name, prev := range r.files {
+		if _, ok := current[name]; !ok && !prev.invalid {
+			changed[prev.locale] = true
+		}
+	}
+
+	// Group the current files by locale
+	byLocale := make(map[string][]string)
+	for name, st := range current {
+		if !st.invalid {
+			byLocale[st.locale] = append(byLocale[st.locale], name)
+		}
+	}
+
+	loaded := make(map[string][]Message)
+	for _, loc := range sortedKeys(changed) {
+		files := byLocale[loc]
+		slices.Sort(files)
+		if len(files) == 0 {
+			// All files were removed; keep the last good version
+			continue
+		}
+
+		msgs, err := r.loadLocale(files)
+		events = append(events, ReloadEvent{Locale: loc, Files: files, Err: err})
+		if err == nil {
+			loaded[loc] = msgs
+		}
+	}
+
+	if len(loaded) > 0 {
+		r.bundle.update(func(locales map[string]*MessageSet) {
+			for loc, msgs := range loaded {
+				setMessages(locales, loc, msgs, false)
+			}
+		})
+	}
+
+	r.files = current
+	return r.emit(events)
+}
+
+// stat returns the state of a file, reading and hashing it only when its
+// modification time or size changed. Directories report ok as false.
+// A file whose locale cannot be determined is recorded as invalid and
+// reported once until it changes.
+func (r *Reloader) stat(name string) (fileState, bool, error) {
+	info, err := fs.Stat(r.fsys, name)
+	if err != nil {
+		return fileState{}, false, err
+	}
+	if info.IsDir() {
+		return fileState{}, false, nil
+	}
+
+	prev, seen := r.files[name]
+	if seen && prev.modTime.Equal(info.ModTime()) && prev.size == info.Size() {
+		return prev, true, nil
+	}
+
+	st := fileState{modTime: info.ModTime(), size: info.Size()}
+	loc, err := r.localeOf(name)
+	if err == nil {
+		st.locale, err = normalizeLocale(loc)
+	}
+	if err != nil {
+		st.invalid = true
+		return st, true, err
+	}
+
+	data, err := fs.ReadFile(r.fsys, name)
+	if err != nil {
+		return fileState{}, false, err
+	}
+	st.hash = sha256.Sum256(data)
+	return st, true, nil
+}
+
+// loadLocale parses all files of a locale, returning their combined
+// messages or the errors of the files that failed.
+func (r *Reloader) loadLocale(files []string) ([]Message, error) {
+	var errs []error
+	var msgs []Message
+	for _, name := range files {
+		data, err := fs.ReadFile(r.fsys, name)
+		if err == nil {
+			var mf *MessagesFile
+			if mf, err = ParseMessagesJSON(data); err == nil {
+				msgs = append(msgs, mf.Messages...)
+				continue
+			}
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", name, err))
+	}
+	return msgs, errors.Join(errs...)
+}
+
+// emit passes events to the callback and returns them.
+func (r *Reloader) emit(events []ReloadEvent) []ReloadEvent {
+	if r.onReload != nil {
+		for _, e := range events {
+			r.onReload(e)
+		}
+	}
+	return events
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,193 @@
+package messages
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestReloader_Check(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		"locales/en.json": {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hello"}]}`), ModTime: t0},
+		"locales/fr.json": {Data: []byte(`{"messages": [{"id": "hello", "translation": "Bonjour"}]}`), ModTime: t0},
+	}
+
+	b := NewBundle("en")
+	var callbacks []ReloadEvent
+	r := NewReloader(b, fsys, "locales/*.json", WithReloadCallback(func(e ReloadEvent) {
+		callbacks = append(callbacks, e)
+	}))
+
+	// Initial load
+	events := r.Check()
+	if len(events) != 2 || events[0].Locale != "en" || events[1].Locale != "fr" {
+		t.Fatalf("initial Check() = %+v, expected en and fr", events)
+	}
+	if len(callbacks) != 2 {
+		t.Errorf("callback called %d times, expected 2", len(callbacks))
+	}
+	if got := b.Localizer("fr").T("hello"); got != "Bonjour" {
+		t.Errorf("fr hello = %q, expected 'Bonjour'", got)
+	}
+
+	// No changes
+	if events := r.Check(); len(events) != 0 {
+		t.Errorf("unchanged Check() = %+v, expected no events", events)
+	}
+
+	// Touched but unchanged content is not reloaded
+	fsys["locales/en.json"].ModTime = t0.Add(time.Second)
+	if events := r.Check(); len(events) != 0 {
+		t.Errorf("touched Check() = %+v, expected no events", events)
+	}
+
+	// Changed content replaces the locale
+	fsys["locales/fr.json"] = &fstest.MapFile{
+		Data:    []byte(`{"messages": [{"id": "hello", "translation": "Salut"}]}`),
+		ModTime: t0.Add(2 * time.Second),
+	}
+	events = r.Check()
+	if len(events) != 1 || events[0].Locale != "fr" || events[0].Err != nil {
+		t.Fatalf("changed Check() = %+v, expected fr reload", events)
+	}
+	if got := b.Localizer("fr").T("hello"); got != "Salut" {
+		t.Errorf("fr hello = %q, expected 'Salut'", got)
+	}
+
+	// A parse error keeps the last good version
+	fsys["locales/fr.json"] = &fstest.MapFile{Data: []byte(`{broken`), ModTime: t0.Add(3 * time.Second)}
+	events = r.Check()
+	if len(events) != 1 || events[0].Err == nil {
+		t.Fatalf("broken Check() = %+v, expected error", events)
+	}
+	if got := b.Localizer("fr").T("hello"); got != "Salut" {
+		t.Errorf("fr hello = %q, expected last good 'Salut'", got)
+	}
+	if events := r.Check(); len(events) != 0 {
+		t.Errorf("Check() after error = %+v, expected error reported once", events)
+	}
+
+	// New locale files are picked up
+	fsys["locales/de.json"] = &fstest.MapFile{Data: []byte(`{"messages": [{"id": "hello", "translation": "Hallo"}]}`), ModTime: t0}
+	events = r.Check()
+	if len(events) != 1 || events[0].Locale != "de" {
+		t.Fatalf("new file Check() = %+v, expected de", events)
+	}
+	if got := b.Localizer("de").T("hello"); got != "Hallo" {
+		t.Errorf("de hello = %q, expected 'Hallo'", got)
+	}
+}
+
+func TestReloader_MultipleFilesPerLocale(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	fsys := fstest.MapFS{
+		"fr/a.json": {Data: []byte(`{"messages": [{"id": "a", "translation": "A"}]}`), ModTime: t0},
+		"fr/b.json": {Data: []byte(`{"messages": [{"id": "b", "translation": "B"}]}`), ModTime: t0},
+		"xx.json":   {Data: []byte(`{"messages": []}`), ModTime: t0},
+	}
+
+	b := NewBundle("en")
+	r := NewReloader(b, fsys, "fr/*.json")
+	r.Check()
+
+	// Removing one file reloads the locale from the remaining files
+	delete(fsys, "fr/b.json")
+	events := r.Check()
+	if len(events) != 1 || events[0].Locale != "fr" || len(events[0].Files) != 1 {
+		t.Fatalf("Check() = %+v, expected fr reload from one file", events)
+	}
+	if m := b.GetMessage("fr", "b"); m != nil {
+		t.Error("message from removed file is still present")
+	}
+	if m := b.GetMessage("fr", "a"); m == nil {
+		t.Error("message from remaining file is missing")
+	}
+
+	// Files whose locale cannot be inferred are reported once
+	r = NewReloader(b, fsys, "*.json", WithReloadLocaleFunc(func(string) (string, error) {
+		return "", ErrNoLocale
+	}))
+	events = r.Check()
+	if len(events) != 1 || !errors.Is(events[0].Err, ErrNoLocale) {
+		t.Errorf("Check() = %+v, expected ErrNoLocale", events)
+	}
+	if events := r.Check(); len(events) != 0 {
+		t.Errorf("second Check() = %+v, expected no events", events)
+	}
+}
+
+// failingFS is a file system whose named file cannot be opened.
+type failingFS struct {
+	fs.FS
+	fail string
+}
+
+func (f failingFS) Open(name string) (fs.File, error) {
+	if name == f.fail {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrPermission}
+	}
+	return f.FS.Open(name)
+}
+
+func TestReloader_UnreadableFileKeepsMessages(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mapFS := fstest.MapFS{
+		"fr/a.json": {Data: []byte(`{"messages": [{"id": "a", "translation": "A"}]}`), ModTime: t0},
+		"fr/b.json": {Data: []byte(`{"messages": [{"id": "b", "translation": "B"}]}`), ModTime: t0},
+	}
+	fsys := &failingFS{FS: mapFS}
+
+	b := NewBundle("en")
+	r := NewReloader(b, fsys, "fr/*.json")
+	r.Check()
+
+	// A file that cannot be read is reported, not treated as removed
+	fsys.fail = "fr/b.json"
+	events := r.Check()
+	if len(events) != 1 || !errors.Is(events[0].Err, fs.ErrPermission) {
+		t.Fatalf("Check() = %+v, expected one permission error", events)
+	}
+	if m := b.GetMessage("fr", "b"); m == nil {
+		t.Error("message from unreadable file was dropped")
+	}
+
+	// Once readable again, the unchanged file is not reloaded
+	fsys.fail = ""
+	if events := r.Check(); len(events) != 0 {
+		t.Errorf("Check() after recovery = %+v, expected no events", events)
+	}
+	if m := b.GetMessage("fr", "b"); m == nil {
+		t.Error("message from recovered file is missing")
+	}
+}
+
+func TestReloader_Run(t *testing.T) {
+	fsys := fstest.MapFS{
+		"en.json": {Data: []byte(`{"messages": [{"id": "hello", "translation": "Hello"}]}`)},
+	}
+
+	b := NewBundle("en")
+	events := make(chan ReloadEvent, 1)
+	r := NewReloader(b, fsys, "*.json", WithPollInterval(time.Millisecond), WithReloadCallback(func(e ReloadEvent) {
+		events <- e
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- r.Run(ctx) }()
+
+	if e := <-events; e.Locale != "en" || e.Err != nil {
+		t.Errorf("first event = %+v, expected en", e)
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, expected context.Canceled", err)
+	}
+	if got := b.Localizer("en").T("hello"); got != "Hello" {
+		t.Errorf("hello = %q, expected 'Hello'", got)
+	}
+}
@@ -0,0 +1,181 @@
+package messages
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// segment is literal text followed by an optional {{.Name}} variable.
+type segment struct {
+	text string // literal text before the variable
+	name string // variable name; empty for trailing text
+	raw  string // the variable as written, kept if it has no value
+}
+
+// compiledTemplate is a translation split into segments once, so that
+// rendering it does no parsing.
+type compiledTemplate struct {
+	segments []segment
+	size     int // length of the literal text
+}
+
+// compileTemplate splits src into segments at variables of the form
+// {{.Name}}, with optional spaces inside the delimiters. Names consist of
+// ASCII letters, digits and underscores. Text that does not form a
+// variable, such as "{{ Name }}", is literal.
+func compileTemplate(src, left, right string) *compiledTemplate {
+	c := &compiledTemplate{}
+	start := 0 // start of the pending literal text
+	for i := 0; i < len(src); {
+		j := strings.Index(src[i:], left)
+		if j < 0 {
+			break
+		}
+		i += j
+		name, end, ok := scanVar(src, i+len(left), right)
+		if !ok {
+			i++
+			continue
+		}
+		c.segments = append(c.segments, segment{text: src[start:i], name: name, raw: src[i:end]})
+		c.size += i - start
+		start, i = end, end
+	}
+	if start < len(src) || len(c.segments) == 0 {
+		c.segments = append(c.segments, segment{text: src[start:]})
+		c.size += len(src) - start
+	}
+	return c
+}
+
+// scanVar scans " .Name }}" at src[i:], returning the name and the end of
+// the right delimiter.
+func scanVar(src string, i int, right string) (string, int, bool) {
+	i = skipSpace(src, i)
+	if i >= len(src) || src[i] != '.' {
+		return "", 0, false
+	}
+	i++
+	nameStart := i
+	for i < len(src) && isWordByte(src[i]) {
+		i++
+	}
+	if i == nameStart {
+		return "", 0, false
+	}
+	name := src[nameStart:i]
+	i = skipSpace(src, i)
+	if !strings.HasPrefix(src[i:], right) {
+		return "", 0, false
+	}
+	return name, i + len(right), true
+}
+
+// skipSpace returns the index of the first non-space byte at or after i.
+func skipSpace(s string, i int) int {
+	for i < len(s) {
+		switch s[i] {
+		case ' ', '\t', '\n', '\f', '\r':
+			i++
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// isWordByte reports whether b is an ASCII letter, digit or underscore.
+func isWordByte(b byte) bool {
+	return b == '_' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+// render substitutes values from data, keeping variables without a value
+// and returning their names.
+func (c *compiledTemplate) render(data *templateData) (string, []string) {
+	if len(c.segments) == 1 && c.segments[0].name == "" {
+		return c.segments[0].text, nil
+	}
+
+	var b strings.Builder
+	b.Grow(c.size + 8*len(c.segments))
+	var missing []string
+	for _, s := range c.segments {
+		b.WriteString(s.text)
+		if s.name == "" {
+			continue
+		}
+		if v, ok := data.lookup(s.name); ok {
+			writeValue(&b, v)
+			continue
+		}
+		b.WriteString(s.raw)
+		if !slices.Contains(missing, s.name) {
+			missing = append(missing, s.name)
+		}
+	}
+	return b.String(), missing
+}
+
+// writeValue writes a value as formatValue formats it, without allocating
+// for common types.
+func writeValue(b *strings.Builder, v any) {
+	var buf [32]byte
+	switch val := v.(type) {
+	case string:
+		b.WriteString(val)
+	case int:
+		b.Write(strconv.AppendInt(buf[:0], int64(val), 10))
+	case int64:
+		b.Write(strconv.AppendInt(buf[:0], val, 10))
+	case float64:
+		b.Write(strconv.AppendFloat(buf[:0], val, 'f', -1, 64))
+	default:
+		// fmt.Sprint rather than Fprintf keeps b on the stack
+		b.WriteString(fmt.Sprint(v))
+	}
+}
+
+// compile precompiles every string of the message's translation, including
+// plural forms and select variants.
+func (m *Message) compile() {
+	left, right := m.delims()
+	m.compiled = make(map[string]*compiledTemplate)
+	var walk func(v any)
+	walk = func(v any) {
+		switch t := v.(type) {
+		case string:
+			if _, ok := m.compiled[t]; !ok {
+				m.compiled[t] = compileTemplate(t, left, right)
+			}
+		case map[string]any:
+			for _, form := range t {
+				walk(form)
+			}
+		}
+	}
+	walk(m.Translation)
+}
+
+// template returns the compiled form of a translation string of m,
+// compiling strings that were not precompiled.
+func (m *Message) template(src string) *compiledTemplate {
+	if c, ok := m.compiled[src]; ok {
+		return c
+	}
+	left, right := m.delims()
+	return compileTemplate(src, left, right)
+}
+
+// delims returns the variable delimiters of the message.
+func (m *Message) delims() (string, string) {
+	left, right := m.LeftDelim, m.RightDelim
+	if left == "" {
+		left = "{{"
+	}
+	if right == "" {
+		right = "}}"
+	}
+	return left, right
+}
@@ -0,0 +1,180 @@
+package messages
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+// legacyVarPattern is the regular expression Localizers used to match
+// {{.Name}} variables before translations were precompiled.
+var legacyVarPattern = regexp.MustCompile(`\{\{\s*\.(\w+)\s*\}\}`)
+
+// legacyReplaceVars is the previous regexp-based substitution, kept to
+// check the compiled path against it and to benchmark both.
+func legacyReplaceVars(template string, data map[string]any) (string, []string) {
+	var missing []string
+	s := legacyVarPattern.ReplaceAllStringFunc(template, func(match string) string {
+		submatch := legacyVarPattern.FindStringSubmatch(match)
+		if len(submatch) < 2 {
+			return match
+		}
+		varName := submatch[1]
+		if v, ok := lookupVar(data, varName); ok {
+			return formatValue(v)
+		}
+		if !slices.Contains(missing, varName) {
+			missing = append(missing, varName)
+		}
+		return match
+	})
+	return s, missing
+}
+
+func TestCompileTemplate_MatchesLegacy(t *testing.T) {
+	data := map[string]any{
+		"Name":  "Ana",
+		"count": 3,
+		"Big":   int64(1) << 40,
+		"Ratio": 0.25,
+		"Flag":  true,
+		"Empty": "",
+	}
+	templates := []string{
+		"",
+		"no variables",
+		"{{.Name}}",
+		"Hello, {{.Name}}!",
+		"{{ .Name }} has {{.Count}} items",
+		"{{\t.Name\n}}",
+		"{{.Name}}{{.Name}}{{.Missing}}{{.Missing}}",
+		"{{{.Name}}}",
+		"{{.Name",
+		"{{ Name }}",
+		"{{.}}",
+		"{{.Na-me}}",
+		"{{.Big}} {{.Ratio}} {{.Flag}} [{{.Empty}}]",
+		"{{if .Name}}x{{end}}",
+		"trailing {{",
+		"ünïcödé {{.Name}} ✓",
+	}
+	for _, tmpl := range templates {
+		want, wantMissing := legacyReplaceVars(tmpl, data)
+		got, gotMissing := compileTemplate(tmpl, "{{", "}}").render(&templateData{vars: data})
+		if got != want || !slices.Equal(gotMissing, wantMissing) {
+			t.Errorf("render(%q) = %q, %v; legacy = %q, %v", tmpl, got, gotMissing, want, wantMissing)
+		}
+	}
+}
+
+func TestCompileTemplate_Delims(t *testing.T) {
+	got, missing := compileTemplate("<<.Name>> << .X >> {{.Name}}", "<<", ">>").render(&templateData{vars: map[string]any{"Name": "Ana"}})
+	if got != "Ana << .X >> {{.Name}}" || !slices.Equal(missing, []string{"X"}) {
+		t.Errorf("render = %q, %v", got, missing)
+	}
+}
+
+func TestMessageSet_SetCompiles(t *testing.T) {
+	m := &Message{ID: "m", Select: "Gender", Translation: map[string]any{
+		"female": map[string]any{"one": "{{.Name}} her item", "other": "{{.Name}} her items"},
+		"other":  "{{.Name}} their items",
+	}}
+	ms := NewMessageSet("en")
+	ms.Set(m)
+	if m.compiled != nil {
+		t.Error("Set modified the caller's message")
+	}
+	stored := ms.Get("m")
+	for _, src := range []string{"{{.Name}} her item", "{{.Name}} her items", "{{.Name}} their items"} {
+		if _, ok := stored.compiled[src]; !ok {
+			t.Errorf("%q was not precompiled", src)
+		}
+	}
+	if v := stored.SelectVariant("female"); v.compiled == nil {
+		t.Error("SelectVariant dropped the compiled translations")
+	}
+}
+
+func TestMessageSet_SetNotStale(t *testing.T) {
+	forms := map[string]any{"one": "{{.Count}} item", "other": "{{.Count}} items"}
+	m := &Message{ID: "items", Translation: forms}
+	ms := NewMessageSet("en")
+	ms.Set(m)
+
+	// Editing the shared translation after Set serves the new text
+	forms["other"] = "{{.Count}} things"
+	m.Translation = "replaced"
+	stored := ms.Get("items")
+	got, _ := stored.template(stored.GetPlural().Other).render(&templateData{vars: map[string]any{"Count": 2}})
+	if got != "2 things" {
+		t.Errorf("render(other) = %q, expected %q", got, "2 things")
+	}
+}
+
+// benchmarkData is typical template data with a few variables.
+var benchmarkData = map[string]any{
+	"Name":   "Ana",
+	"Count":  42,
+	"Folder": "documents",
+}
+
+const benchmarkTemplate = "{{.Name}} moved {{.Count}} files to {{.Folder}}."
+
+func BenchmarkSubstitute_Legacy(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		legacyReplaceVars(benchmarkTemplate, benchmarkData)
+	}
+}
+
+func BenchmarkSubstitute_Compiled(b *testing.B) {
+	c := compileTemplate(benchmarkTemplate, "{{", "}}")
+	b.ReportAllocs()
+	for b.Loop() {
+		c.render(&templateData{vars: benchmarkData})
+	}
+}
+
+func BenchmarkLocalizer_Tf(b *testing.B) {
+	bundle := NewBundle("en")
+	_ = bundle.AddLocale("en", []byte(`{"messages": [
+		{"id": "moved", "translation": "{{.Name}} moved {{.Count}} files to {{.Folder}}."}
+	]}`))
+	l := bundle.Localizer("en")
+	b.ReportAllocs()
+	for b.Loop() {
+		l.Tf("moved", benchmarkData)
+	}
+}
+
+func BenchmarkLocalizer_Tn(b *testing.B) {
+	bundle := NewBundle("en")
+	_ = bundle.AddLocale("en", []byte(`{"messages": [
+		{"id": "files", "translation": {"one": "{{.Count}} file", "other": "{{.Count}} files"}}
+	]}`))
+	l := bundle.Localizer("en")
+	b.ReportAllocs()
+	for i := 0; b.Loop(); i++ {
+		l.Tn("files", i%10)
+	}
+}
+
+func BenchmarkLocalizer_T(b *testing.B) {
+	bundle := NewBundle("en")
+	_ = bundle.AddLocale("en", []byte(`{"messages": [{"id": "title", "translation": "Changelog"}]}`))
+	l := bundle.Localizer("en")
+	b.ReportAllocs()
+	for b.Loop() {
+		l.T("title")
+	}
+}
+
+func ExampleLocalizer_Tf() {
+	bundle := NewBundle("en")
+	_ = bundle.AddLocale("en", []byte(`{"messages": [
+		{"id": "greeting", "translation": "Hello, {{.Name}}!"}
+	]}`))
+	fmt.Println(bundle.Localizer("en").Tf("greeting", map[string]any{"Name": "Ana"}))
+	// Output: Hello, Ana!
+}
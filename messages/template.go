@@ -0,0 +1,133 @@
+package messages
+
+import (
+	"strings"
+	"text/template"
+)
+
+// TemplateOption configures the text/template rendering mode enabled by
+// Bundle.EnableTemplates.
+type TemplateOption func(*templateConfig)
+
+// templateConfig is the configuration of the template rendering mode. It
+// is immutable once published.
+type templateConfig struct {
+	funcs       template.FuncMap
+	left, right string
+}
+
+// WithTemplateFuncs adds functions that message templates can call.
+func WithTemplateFuncs(funcs template.FuncMap) TemplateOption {
+	return func(c *templateConfig) {
+		for name, fn := range funcs {
+			c.funcs[name] = fn
+		}
+	}
+}
+
+// WithTemplateDelims sets the action delimiters of message templates. An
+// empty delimiter means the default, "{{" or "}}". A message's own
+// LeftDelim and RightDelim take precedence.
+func WithTemplateDelims(left, right string) TemplateOption {
+	return func(c *templateConfig) {
+		c.left, c.right = left, right
+	}
+}
+
+// templateKey identifies a parsed template. Templates are cached per
+// configuration, locale and message ID; the source distinguishes the
+// plural forms and select variants of a message.
+type templateKey struct {
+	config *templateConfig
+	locale string
+	id     string
+	src    string
+}
+
+// EnableTemplates switches the bundle's Localizers from plain {{.Name}}
+// substitution to full text/template execution, so translations can use
+// conditionals, range, pipelines and functions:
+//
+//	{{if .Admin}}Welcome back, {{.Name}}{{else}}Hello, {{.Name}}{{end}}
+//
+// Template data is the variables passed to the Localizer, with structs
+// converted to a map as described for Tf, including Count for plural
+// messages. As in go-i18n, templates follow text/template
+// semantics: variable names are case-sensitive, and a missing variable is
+// false in conditions and prints as "<no value>". Parse and execution
+// errors are returned by the error-returning methods, such as Localize, as
+// a *TemplateError together with the raw translation; T, Tf and Tn return
+// the raw translation.
+//
+// Parsed templates are cached per locale and message ID. Calling
+// EnableTemplates again replaces the configuration.
+func (b *Bundle) EnableTemplates(opts ...TemplateOption) {
+	c := &templateConfig{funcs: template.FuncMap{}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	b.templates.Store(c)
+	b.parsed.Clear()
+}
+
+// parsedTemplate is a cached parse result, including parse errors so that
+// a broken translation is parsed only once.
+type parsedTemplate struct {
+	tmpl *template.Template
+	err  error
+}
+
+// template returns the parsed template for a translation of m in a locale,
+// caching it.
+func (b *Bundle) template(c *templateConfig, loc string, m *Message, src string) (*template.Template, error) {
+	key := templateKey{config: c, locale: loc, id: m.ID, src: src}
+	if p, ok := b.parsed.Load(key); ok {
+		return p.(parsedTemplate).tmpl, p.(parsedTemplate).err
+	}
+
+	left, right := c.delims(m)
+	t, err := template.New(m.ID).
+		Delims(left, right).
+		Funcs(c.funcs).
+		Parse(src)
+	b.parsed.Store(key, parsedTemplate{tmpl: t, err: err})
+	return t, err
+}
+
+// delims returns the delimiters for the templates of m.
+func (c *templateConfig) delims(m *Message) (string, string) {
+	left, right := c.left, c.right
+	if m.LeftDelim != "" {
+		left = m.LeftDelim
+	}
+	if m.RightDelim != "" {
+		right = m.RightDelim
+	}
+	if left == "" {
+		left = "{{"
+	}
+	if right == "" {
+		right = "}}"
+	}
+	return left, right
+}
+
+// execute fills in the Result text by executing a translation of m as a
+// template. On error, the text is the raw translation.
+func (l *Localizer) execute(r Result, c *templateConfig, m *Message, src string, data map[string]any) (Result, error) {
+	if left, _ := c.delims(m); !strings.Contains(src, left) {
+		r.Text = src
+		return r, nil
+	}
+
+	t, err := l.bundle.template(c, r.Locale, m, src)
+	if err == nil {
+		var sb strings.Builder
+		if err = t.Execute(&sb, data); err == nil {
+			r.Text = sb.String()
+			return r, nil
+		}
+	}
+	r.Text = src
+	return r, &TemplateError{ID: m.ID, Locale: r.Locale, Err: err}
+}
@@ -0,0 +1,135 @@
+package messages
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestBundle_EnableTemplates(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "welcome", "translation": "{{if .Admin}}Welcome back, {{.Name}}{{else}}Hello, {{.Name}}{{end}}"},
+		{"id": "list", "translation": "{{range $i, $n := .Names}}{{if $i}}, {{end}}{{$n}}{{end}}"},
+		{"id": "shout", "translation": "{{.Name | upper}}!"},
+		{"id": "files", "translation": {
+			"one": "{{.Count}} file{{if .Dir}} in {{.Dir}}{{end}}",
+			"other": "{{.Count}} files{{if .Dir}} in {{.Dir}}{{end}}"
+		}},
+		{"id": "plain", "translation": "No actions here"},
+		{"id": "custom", "leftDelim": "<<", "rightDelim": ">>", "translation": "<<.Name>> {{literal}}"}
+	]}`))
+	b.EnableTemplates(WithTemplateFuncs(template.FuncMap{"upper": strings.ToUpper}))
+	l := b.Localizer("en")
+
+	tests := []struct {
+		id       string
+		data     map[string]any
+		expected string
+	}{
+		{"welcome", map[string]any{"Admin": true, "Name": "Ana"}, "Welcome back, Ana"},
+		{"welcome", map[string]any{"Admin": false, "Name": "Ana"}, "Hello, Ana"},
+		{"list", map[string]any{"Names": []string{"a", "b", "c"}}, "a, b, c"},
+		{"shout", map[string]any{"Name": "hey"}, "HEY!"},
+		{"plain", nil, "No actions here"},
+		{"custom", map[string]any{"Name": "Ana"}, "Ana {{literal}}"},
+	}
+	for _, tt := range tests {
+		got, err := l.Localize(tt.id, tt.data)
+		if err != nil || got != tt.expected {
+			t.Errorf("Localize(%s) = %q, %v, expected %q", tt.id, got, err, tt.expected)
+		}
+	}
+
+	got, err := l.LocalizePlural("files", 1, map[string]any{"Dir": "docs"})
+	if err != nil || got != "1 file in docs" {
+		t.Errorf("LocalizePlural(files, 1) = %q, %v", got, err)
+	}
+	if got := l.Tn("files", 3); got != "3 files" {
+		t.Errorf("Tn(files, 3) = %q", got)
+	}
+}
+
+func TestBundle_EnableTemplates_Delims(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "greeting", "translation": "[[if .Name]]Hi [[.Name]][[end]] {{.Name}}"}
+	]}`))
+	b.EnableTemplates(WithTemplateDelims("[[", "]]"))
+
+	got := b.Localizer("en").Tf("greeting", map[string]any{"Name": "Ana"})
+	if got != "Hi Ana {{.Name}}" {
+		t.Errorf("Tf(greeting) = %q", got)
+	}
+}
+
+func TestBundle_EnableTemplates_Errors(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [
+		{"id": "broken", "translation": "{{if .Name}}unterminated"},
+		{"id": "bad_call", "translation": "{{len .Count}}"},
+		{"id": "unknown_func", "translation": "{{.Name | nope}}"}
+	]}`))
+	b.EnableTemplates()
+
+	collector := NewIssueCollector()
+	l := b.Localizer("en").WithIssueHook(collector.Record)
+
+	raw := map[string]string{
+		"broken":       "{{if .Name}}unterminated",
+		"bad_call":     "{{len .Count}}",
+		"unknown_func": "{{.Name | nope}}",
+	}
+	for _, id := range []string{"broken", "bad_call", "unknown_func"} {
+		got, err := l.Localize(id, map[string]any{"Count": 1})
+		var te *TemplateError
+		if !errors.As(err, &te) || !errors.Is(err, ErrTemplate) {
+			t.Errorf("Localize(%s) error = %v, expected *TemplateError", id, err)
+			continue
+		}
+		if te.ID != id || te.Locale != "en" {
+			t.Errorf("TemplateError = %+v", te)
+		}
+		if got != raw[id] {
+			t.Errorf("Localize(%s) text = %q, expected raw translation %q", id, got, raw[id])
+		}
+	}
+
+	counts := collector.Counts()
+	if len(counts) != 3 || counts[0].Kind != IssueTemplateError {
+		t.Errorf("issue counts = %+v, expected 3 template errors", counts)
+	}
+}
+
+func TestBundle_EnableTemplates_ParseErrorCached(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "broken", "translation": "{{if .Name}}unterminated"}]}`))
+	b.EnableTemplates()
+	l := b.Localizer("en")
+
+	_, err1 := l.Localize("broken", nil)
+	_, err2 := l.Localize("broken", nil)
+	var te1, te2 *TemplateError
+	if !errors.As(err1, &te1) || !errors.As(err2, &te2) {
+		t.Fatalf("Localize(broken) errors = %v, %v, expected *TemplateError", err1, err2)
+	}
+	if te1.Err != te2.Err {
+		t.Error("broken template was parsed again instead of using the cached error")
+	}
+}
+
+func TestBundle_EnableTemplates_Reload(t *testing.T) {
+	b := NewBundle("en")
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "m", "translation": "{{.N}} old"}]}`))
+	b.EnableTemplates()
+	l := b.Localizer("en")
+	if got := l.Tf("m", map[string]any{"N": 1}); got != "1 old" {
+		t.Fatalf("Tf(m) = %q", got)
+	}
+
+	_ = b.AddLocale("en", []byte(`{"messages": [{"id": "m", "translation": "{{.N}} new"}]}`))
+	if got := l.Tf("m", map[string]any{"N": 1}); got != "1 new" {
+		t.Errorf("Tf(m) after reload = %q", got)
+	}
+}